@@ -94,6 +94,13 @@ type BatteryCapacity interface {
 	Capacity() float64
 }
 
+// BatteryCapacities provides usable and nominal battery capacity in kWh.
+// Usable capacity is used for energy calculations, nominal capacity includes the buffer not available for charging.
+type BatteryCapacities interface {
+	UsableCapacity() float64
+	NominalCapacity() float64
+}
+
 // ChargeState provides current charging status
 type ChargeState interface {
 	Status() (ChargeStatus, error)
@@ -117,7 +124,7 @@ type ChargerEx interface {
 	MaxCurrentMillis(current float64) error
 }
 
-// PhaseSwitcher provides 1p3p switching
+// PhaseSwitcher provides 1p3p switching. Phases must be either 1 or 3.
 type PhaseSwitcher interface {
 	Phases1p3p(phases int) error
 }
@@ -127,6 +134,23 @@ type Diagnosis interface {
 	Diagnose()
 }
 
+// HealthReporter reports the health of an upstream api connection.
+// It returns the time of the last successful update and the most recent update error.
+type HealthReporter interface {
+	Health() (time.Time, error)
+}
+
+// ResponseCapturer provides the last raw API response for debugging with credentials redacted
+type ResponseCapturer interface {
+	LastResponse() []byte
+}
+
+// TokenController reports OAuth token expiry and granted scopes and allows forcing a token refresh
+type TokenController interface {
+	TokenInfo() (time.Time, []string, error)
+	ForceRefresh() error
+}
+
 // ChargeTimer provides current charge cycle duration
 type ChargeTimer interface {
 	ChargingTime() (time.Duration, error)
@@ -142,6 +166,12 @@ type Identifier interface {
 	Identify() (string, error)
 }
 
+// VINIdentifier provides the vehicle identification number.
+// It is implemented by chargers reporting the connected vehicle's VIN, e.g. via ISO 15118, and by vehicles.
+type VINIdentifier interface {
+	VIN() (string, error)
+}
+
 // Authorizer authorizes a charging session by supplying RFID credentials
 type Authorizer interface {
 	Authorize(key string) error
@@ -165,26 +195,92 @@ type VehicleFinishTimer interface {
 	FinishTime() (time.Time, error)
 }
 
+// VehicleUpdated provides the time the vehicle data was last reported by the vehicle
+type VehicleUpdated interface {
+	Updated() (time.Time, error)
+}
+
+// VehicleChargeCurve provides the vehicle's maximum charge power in W depending on soc, 0 if unknown
+type VehicleChargeCurve interface {
+	ChargePower(soc float64) float64
+}
+
 // VehicleRange provides the vehicles remaining km range
 type VehicleRange interface {
 	Range() (int64, error)
 }
 
+// VehicleRangeDetail provides the vehicles rated (e.g. EPA/WLTP) and current real-world km range separately
+type VehicleRangeDetail interface {
+	RangeDetail() (rated, current int64, err error)
+}
+
 // VehicleClimater provides climatisation data
 type VehicleClimater interface {
 	Climater() (bool, error)
 }
 
+// VehicleTemperature provides the vehicles cabin and ambient temperature in °C
+type VehicleTemperature interface {
+	InsideTemp() (float64, error)
+	OutsideTemp() (float64, error)
+}
+
 // VehicleOdometer returns the vehicles milage
 type VehicleOdometer interface {
 	Odometer() (float64, error)
 }
 
+// VehicleCurrent provides the vehicle's AC charging current in A as measured by the vehicle
+type VehicleCurrent interface {
+	ChargeCurrent() (float64, error)
+}
+
+// VehicleEnergy provides the energy in kWh the vehicle has charged over its lifetime
+type VehicleEnergy interface {
+	LifetimeEnergy() (float64, error)
+}
+
+// VehicleAvailability determines if the vehicle is available for charging at the given time
+type VehicleAvailability interface {
+	Available(time.Time) bool
+}
+
+// VehicleMinSoc provides the soc floor below which the vehicle is charged regardless of surplus or price
+type VehicleMinSoc interface {
+	MinSoc() int
+}
+
+// VehicleTirePressure returns the vehicles tire pressures in bar
+type VehicleTirePressure interface {
+	TirePressure() (frontLeft, frontRight, rearLeft, rearRight float64, err error)
+}
+
 // VehiclePosition returns the vehicles position in latitude and longitude
 type VehiclePosition interface {
 	Position() (float64, float64, error)
 }
 
+// VehicleServiceInterval provides the distance in km between vehicle services
+type VehicleServiceInterval interface {
+	ServiceInterval() float64
+}
+
+// VehicleRangeBuffer provides the reserve range in km the vehicle should always arrive with
+type VehicleRangeBuffer interface {
+	RangeBuffer() float64
+}
+
+// VehiclePreferredLoadpoint returns the title of the loadpoint the vehicle is usually connected to
+type VehiclePreferredLoadpoint interface {
+	PreferredLoadpoint() string
+}
+
+// VehiclePresent returns if the vehicle is at home
+type VehiclePresent interface {
+	Present() (bool, error)
+}
+
 // SocLimiter returns the vehicles charge limit
 type SocLimiter interface {
 	TargetSoc() (float64, error)
@@ -196,6 +292,40 @@ type VehicleChargeController interface {
 	StopCharge() error
 }
 
+// VehicleLock allows to lock/unlock the vehicle
+type VehicleLock interface {
+	Lock() error
+	Unlock() error
+}
+
+// VehicleLocked returns if the vehicle is locked
+type VehicleLocked interface {
+	Locked() (bool, error)
+}
+
+// VehicleCurrentController allows setting the charging current in A on the vehicle side
+type VehicleCurrentController interface {
+	MaxCurrent(current float64) error
+}
+
+// ChargeSchedule is the vehicle's native charge schedule. A zero departure disables the schedule.
+type ChargeSchedule struct {
+	Departure time.Time
+	Soc       int
+}
+
+// VehicleChargeScheduler reads and sets the vehicle's native charge schedule
+type VehicleChargeScheduler interface {
+	ChargeSchedule() (ChargeSchedule, error)
+	SetChargeSchedule(ChargeSchedule) error
+}
+
+// VehicleClimateController allows to start/stop climatisation on the vehicle side
+type VehicleClimateController interface {
+	StartClimater() error
+	StopClimater() error
+}
+
 // Resurrector provides wakeup calls to the vehicle with an API call or a CP interrupt from the charger
 type Resurrector interface {
 	WakeUp() error
@@ -214,6 +344,24 @@ type AuthProvider interface {
 	LogoutHandler() http.HandlerFunc
 }
 
+// WebhookProvider optionally provides a handler for receiving push updates
+type WebhookProvider interface {
+	WebhookHandler() http.HandlerFunc
+}
+
+// NameDescriber optionally provides the configured name
+type NameDescriber interface {
+	Name() string
+}
+
+// VehicleName returns the vehicle's configured name or its title if not available
+func VehicleName(v Vehicle) string {
+	if vn, ok := v.(NameDescriber); ok && vn.Name() != "" {
+		return vn.Name()
+	}
+	return v.Title()
+}
+
 // IconDescriber optionally provides an icon
 type IconDescriber interface {
 	Icon() string
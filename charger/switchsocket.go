@@ -48,16 +48,17 @@ func (c *switchSocket) Status() (api.ChargeStatus, error) {
 	return res, err
 }
 
-// MaxCurrent implements the api.Charger interface
+// MaxCurrent implements the api.Charger interface.
+// Switch sockets can only switch on/off and don't support current control.
 func (c *switchSocket) MaxCurrent(current int64) error {
-	return nil
+	return api.ErrNotAvailable
 }
 
 var _ api.ChargerEx = (*switchSocket)(nil)
 
 // MaxCurrentMillis implements the api.ChargerEx interface
 func (c *switchSocket) MaxCurrentMillis(current float64) error {
-	return nil
+	return api.ErrNotAvailable
 }
 
 var _ api.Meter = (*switchSocket)(nil)
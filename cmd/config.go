@@ -15,6 +15,7 @@ import (
 	"github.com/dustin/go-humanize"
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/charger"
+	"github.com/evcc-io/evcc/cmd/shutdown"
 	"github.com/evcc-io/evcc/meter"
 	"github.com/evcc-io/evcc/provider/mqtt"
 	"github.com/evcc-io/evcc/push"
@@ -253,10 +254,22 @@ func (cp *ConfigProvider) configureChargers(conf config) error {
 	return g.Wait()
 }
 
+// nameSetter is implemented by vehicles keeping their configured name
+type nameSetter interface {
+	SetName(string)
+}
+
 func (cp *ConfigProvider) configureVehicles(conf config) error {
 	var mu sync.Mutex
 	g, _ := errgroup.WithContext(context.Background())
 
+	// stop vehicle polling and pending requests on shutdown
+	shutdown.Register(func() {
+		if err := vehicle.Close(); err != nil {
+			log.ERROR.Println("vehicles:", err)
+		}
+	})
+
 	cp.vehicles = make(map[string]api.Vehicle)
 	for id, cc := range conf.Vehicles {
 		if cc.Name == "" {
@@ -265,6 +278,13 @@ func (cp *ConfigProvider) configureVehicles(conf config) error {
 
 		cc := cc
 
+		if tronityAllVehicles(cc) {
+			g.Go(func() error {
+				return cp.configureTronityVehicles(&mu, cc)
+			})
+			continue
+		}
+
 		g.Go(func() error {
 			v, err := vehicle.NewFromConfig(cc.Type, cc.Other)
 			if err != nil {
@@ -284,6 +304,10 @@ func (cp *ConfigProvider) configureVehicles(conf config) error {
 				v.SetTitle(strings.Title(cc.Name))
 			}
 
+			if ns, ok := v.(nameSetter); ok {
+				ns.SetName(cc.Name)
+			}
+
 			mu.Lock()
 			defer mu.Unlock()
 
@@ -299,7 +323,66 @@ func (cp *ConfigProvider) configureVehicles(conf config) error {
 	return g.Wait()
 }
 
-// webControl handles routing for devices. For now only api.AuthProvider related routes
+// configureTronityVehicles adds all vehicles of a Tronity account. Multiple vehicles are named by appending their index.
+func (cp *ConfigProvider) configureTronityVehicles(mu *sync.Mutex, cc qualifiedConfig) error {
+	vehicles, err := vehicle.NewTronityVehicles(cc.Other)
+	if err != nil {
+		var ce *util.ConfigError
+		if errors.As(err, &ce) {
+			return fmt.Errorf("cannot create vehicle '%s': %w", cc.Name, err)
+		}
+
+		// wrap non-config vehicle errors to prevent fatals
+		log.ERROR.Printf("creating vehicle %s failed: %v", cc.Name, err)
+		vehicles = []api.Vehicle{wrapper.New(cc.Name, cc.Other, err)}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	for i, v := range vehicles {
+		name := cc.Name
+		if len(vehicles) > 1 {
+			name = fmt.Sprintf("%s%d", cc.Name, i+1)
+		}
+
+		// ensure vehicle config has title
+		if v.Title() == "" {
+			//lint:ignore SA1019 as Title is safe on ascii
+			v.SetTitle(strings.Title(name))
+		}
+
+		if ns, ok := v.(nameSetter); ok {
+			ns.SetName(name)
+		}
+
+		if _, exists := cp.vehicles[name]; exists {
+			return fmt.Errorf("duplicate vehicle name: %s already defined and must be unique", name)
+		}
+
+		cp.vehicles[name] = v
+	}
+
+	return nil
+}
+
+// tronityAllVehicles checks if all vehicles of a Tronity account are enabled.
+// Otherwise, configs without vin use the single vehicle path which lists the account's vins.
+func tronityAllVehicles(cc qualifiedConfig) bool {
+	return strings.EqualFold(cc.Type, "tronity") && hasValue(cc.Other, "vehicles", "all")
+}
+
+// hasValue checks if the config contains the case-insensitive key with the case-insensitive string value
+func hasValue(other map[string]interface{}, key, value string) bool {
+	for k, v := range other {
+		if s, ok := v.(string); ok && strings.EqualFold(k, key) && strings.EqualFold(s, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// webControl handles routing for devices. For now only api.AuthProvider and api.WebhookProvider related routes
 func (cp *ConfigProvider) webControl(conf networkConfig, router *mux.Router, paramC chan<- util.Param) {
 	auth := router.PathPrefix("/oauth").Subrouter()
 	auth.Use(handlers.CompressHandler)
@@ -324,6 +407,15 @@ func (cp *ConfigProvider) webControl(conf networkConfig, router *mux.Router, par
 	for _, k := range keys {
 		v := cp.vehicles[k]
 
+		if provider, ok := v.(api.WebhookProvider); ok {
+			if handler := provider.WebhookHandler(); handler != nil {
+				path := fmt.Sprintf("/webhook/vehicles/%s", k)
+				router.Methods(http.MethodPost).Path(path).HandlerFunc(handler)
+
+				log.INFO.Printf("ensure the webhook is configured for %s: %s%s", v.Title(), baseURI, path)
+			}
+		}
+
 		if provider, ok := v.(api.AuthProvider); ok {
 			id += 1
 
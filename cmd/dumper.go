@@ -150,6 +150,14 @@ func (d *dumper) Dump(name string, v interface{}) {
 		}
 	}
 
+	if v, ok := v.(api.VehicleRangeDetail); ok {
+		if rated, current, err := v.RangeDetail(); err != nil {
+			fmt.Fprintf(w, "Range (rated/current):\t%v\n", err)
+		} else {
+			fmt.Fprintf(w, "Range (rated/current):\t%vkm / %vkm\n", rated, current)
+		}
+	}
+
 	if v, ok := v.(api.VehicleOdometer); ok {
 		if odo, err := v.Odometer(); err != nil {
 			fmt.Fprintf(w, "Odometer:\t%v\n", err)
@@ -158,6 +166,14 @@ func (d *dumper) Dump(name string, v interface{}) {
 		}
 	}
 
+	if v, ok := v.(api.VehicleEnergy); ok {
+		if energy, err := v.LifetimeEnergy(); err != nil {
+			fmt.Fprintf(w, "Lifetime energy:\t%v\n", err)
+		} else {
+			fmt.Fprintf(w, "Lifetime energy:\t%.1fkWh\n", energy)
+		}
+	}
+
 	if v, ok := v.(api.VehicleFinishTimer); ok {
 		if ft, err := v.FinishTime(); err != nil {
 			fmt.Fprintf(w, "Finish time:\t%v\n", err)
@@ -174,6 +190,27 @@ func (d *dumper) Dump(name string, v interface{}) {
 		}
 	}
 
+	if v, ok := v.(api.VehicleTemperature); ok {
+		if temp, err := v.InsideTemp(); err != nil {
+			fmt.Fprintf(w, "Inside temp:\t%v\n", err)
+		} else {
+			fmt.Fprintf(w, "Inside temp:\t%.1f°C\n", temp)
+		}
+		if temp, err := v.OutsideTemp(); err != nil {
+			fmt.Fprintf(w, "Outside temp:\t%v\n", err)
+		} else {
+			fmt.Fprintf(w, "Outside temp:\t%.1f°C\n", temp)
+		}
+	}
+
+	if v, ok := v.(api.VehicleLocked); ok {
+		if locked, err := v.Locked(); err != nil {
+			fmt.Fprintf(w, "Locked:\t%v\n", err)
+		} else {
+			fmt.Fprintf(w, "Locked:\t%v\n", locked)
+		}
+	}
+
 	if v, ok := v.(api.VehiclePosition); ok {
 		if lat, lon, err := v.Position(); err != nil {
 			fmt.Fprintf(w, "Position:\t%v\n", err)
@@ -182,6 +219,14 @@ func (d *dumper) Dump(name string, v interface{}) {
 		}
 	}
 
+	if v, ok := v.(api.VehicleTirePressure); ok {
+		if fl, fr, rl, rr, err := v.TirePressure(); err != nil {
+			fmt.Fprintf(w, "Tire pressure:\t%v\n", err)
+		} else {
+			fmt.Fprintf(w, "Tire pressure:\t%.1f/%.1f/%.1f/%.1fbar\n", fl, fr, rl, rr)
+		}
+	}
+
 	if v, ok := v.(api.SocLimiter); ok {
 		if targetSoc, err := v.TargetSoc(); err != nil {
 			fmt.Fprintf(w, "Target Soc:\t%v\n", err)
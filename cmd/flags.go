@@ -11,6 +11,9 @@ const (
 	flagHeaders            = "log-headers"
 	flagHeadersDescription = "Log headers"
 
+	flagDryRun            = "dry-run"
+	flagDryRunDescription = "Log charge control commands instead of executing them"
+
 	flagName            = "name"
 	flagNameDescription = "Select %s by name"
 
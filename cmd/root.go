@@ -13,12 +13,14 @@ import (
 	"time"
 
 	"github.com/evcc-io/evcc/core"
+	"github.com/evcc-io/evcc/provider"
 	"github.com/evcc-io/evcc/push"
 	"github.com/evcc-io/evcc/server"
 	"github.com/evcc-io/evcc/server/modbus"
 	"github.com/evcc-io/evcc/server/updater"
 	"github.com/evcc-io/evcc/util"
 	"github.com/evcc-io/evcc/util/pipe"
+	"github.com/evcc-io/evcc/util/request"
 	"github.com/evcc-io/evcc/util/sponsor"
 	"github.com/evcc-io/evcc/util/telemetry"
 	"github.com/fatih/structs"
@@ -59,6 +61,7 @@ func init() {
 	rootCmd.PersistentFlags().BoolP("help", "h", false, "Help")
 
 	rootCmd.PersistentFlags().Bool(flagHeaders, false, flagHeadersDescription)
+	rootCmd.PersistentFlags().Bool(flagDryRun, false, flagDryRunDescription)
 
 	// config file options
 	rootCmd.PersistentFlags().StringP("log", "l", "info", "Log level (fatal, error, warn, info, debug, trace)")
@@ -102,6 +105,8 @@ func initConfig() {
 	// print version
 	util.LogLevel("info", nil)
 	log.INFO.Printf("evcc %s", server.FormattedVersion())
+
+	request.UserAgent = "evcc/" + server.Version
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
@@ -145,6 +150,10 @@ func runRoot(cmd *cobra.Command, args []string) {
 	// metrics
 	if viper.GetBool("metrics") {
 		httpd.Router().Handle("/metrics", promhttp.Handler())
+
+		// per client and cache instrumentation
+		request.Metrics = true
+		provider.Metrics = true
 	}
 
 	// pprof
@@ -269,7 +278,7 @@ func runRoot(cmd *cobra.Command, args []string) {
 		if sponsor.Subject != "" {
 			valueChan <- util.Param{Key: "sponsor", Val: sponsor.Subject}
 			var validDuration time.Duration
-			if d := time.Until(sponsor.ExpiresAt); d > 0 && d < 30*24*time.Hour {
+			if d := time.Until(sponsor.ExpiresAt()); d > 0 && d < 30*24*time.Hour {
 				validDuration = d
 			}
 			valueChan <- util.Param{Key: "sponsorTokenExpires", Val: validDuration}
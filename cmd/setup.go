@@ -67,13 +67,28 @@ func configureEnvironment(cmd *cobra.Command, conf config) (err error) {
 		request.LogHeaders = true
 	}
 
+	// charge control commands are logged only
+	if cmd.Flags().Lookup(flagDryRun).Changed {
+		util.DryRun = true
+		log.WARN.Println("dry run: charge control commands will not be executed")
+	}
+
 	// setup machine id
 	if conf.Plant != "" {
 		err = machine.CustomID(conf.Plant)
 	}
 
+	// setup persistence
+	if err == nil && conf.Database.Dsn != "" {
+		err = configureDatabase(conf.Database)
+	}
+
 	// setup sponsorship (allow env override)
 	if err == nil && conf.SponsorToken != "" {
+		// cache authorization to survive sponsor service outages
+		if conf.Database.Dsn != "" {
+			sponsor.Cache = settings.NewStore("sponsor")
+		}
 		err = sponsor.ConfigureSponsorship(conf.SponsorToken)
 	}
 
@@ -82,11 +97,6 @@ func configureEnvironment(cmd *cobra.Command, conf config) (err error) {
 		err = locale.Init()
 	}
 
-	// setup persistence
-	if err == nil && conf.Database.Dsn != "" {
-		err = configureDatabase(conf.Database)
-	}
-
 	// setup mqtt client listener
 	if err == nil && conf.Mqtt.Broker != "" {
 		err = configureMQTT(conf.Mqtt)
@@ -35,3 +35,14 @@ func TestYamlOff(t *testing.T) {
 		t.Errorf("expected `off`, got %s", lp.Mode)
 	}
 }
+
+func TestTronityAllVehicles(t *testing.T) {
+	if !tronityAllVehicles(qualifiedConfig{Type: "tronity", Other: map[string]interface{}{"Vehicles": "All"}}) {
+		t.Error("expected all vehicles")
+	}
+
+	// single vehicle by default, also without vin
+	if tronityAllVehicles(qualifiedConfig{Type: "tronity", Other: map[string]interface{}{"user": "foo"}}) {
+		t.Error("expected single vehicle")
+	}
+}
@@ -112,11 +112,13 @@ func tronityAuthorize(addr string, oc *oauth2.Config) (*oauth2.Token, error) {
 }
 
 func tronityToken(conf config, vehicleConf qualifiedConfig) (*oauth2.Token, error) {
-	var cc struct {
+	cc := struct {
 		Credentials vehicle.ClientCredentials
 		RedirectURI string
+		URI         string
+		Env         string
 		Other       map[string]interface{} `mapstructure:",remain"`
-	}
+	}{}
 
 	if err := util.DecodeOther(vehicleConf.Other, &cc); err != nil {
 		return nil, err
@@ -126,7 +128,12 @@ func tronityToken(conf config, vehicleConf qualifiedConfig) (*oauth2.Token, erro
 		return nil, err
 	}
 
-	oc, err := tronity.OAuth2Config(cc.Credentials.ID, cc.Credentials.Secret)
+	env, err := tronity.ResolveEnvironment(cc.Env, cc.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	oc, err := tronity.OAuth2Config(cc.Credentials.ID, cc.Credentials.Secret, env.AuthURI)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/evcc-io/evcc/vehicle"
+	"github.com/spf13/cobra"
+)
+
+// vehicleDiscoverCmd represents the vehicle discover command
+var vehicleDiscoverCmd = &cobra.Command{
+	Use:   "discover",
+	Short: "List vehicles available for configured account credentials",
+	Run:   runVehicleDiscover,
+}
+
+func init() {
+	vehicleCmd.AddCommand(vehicleDiscoverCmd)
+}
+
+func runVehicleDiscover(cmd *cobra.Command, args []string) {
+	// load config
+	if err := loadConfigFile(&conf); err != nil {
+		fatal(err)
+	}
+
+	// setup environment
+	if err := configureEnvironment(cmd, conf); err != nil {
+		fatal(err)
+	}
+
+	var found bool
+	for _, cc := range conf.Vehicles {
+		if strings.ToLower(cc.Type) != "tronity" {
+			continue
+		}
+
+		found = true
+
+		vehicles, err := vehicle.DiscoverTronityVehicles(cc.Other)
+		if err != nil {
+			log.ERROR.Printf("%s: %v", cc.Name, err)
+			continue
+		}
+
+		fmt.Println(cc.Name)
+		fmt.Println()
+
+		tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "  VIN\tName")
+		for _, v := range vehicles {
+			fmt.Fprintf(tw, "  %s\t%s\n", v.VIN, v.DisplayName)
+		}
+		tw.Flush()
+		fmt.Println()
+	}
+
+	if !found {
+		log.FATAL.Fatal("no vehicle supporting discovery configured")
+	}
+}
@@ -0,0 +1,46 @@
+package core
+
+// minEfficiencyEnergy is the charged energy in kWh required for a meaningful efficiency
+const minEfficiencyEnergy = 1.0
+
+// ChargeEfficiency relates the energy stored in the vehicle battery to the energy charged from the grid.
+// Both counters are compared from the first reading of the session, averaging the efficiency over the session.
+type ChargeEfficiency struct {
+	started      bool
+	vehicleStart float64  // vehicle lifetime energy at first reading (kWh)
+	chargedStart float64  // charged energy at first reading (kWh)
+	efficiency   *float64 // efficiency (%), nil if unknown
+}
+
+// Reset clears all readings
+func (ce *ChargeEfficiency) Reset() {
+	*ce = ChargeEfficiency{}
+}
+
+// Update adds a reading of the vehicle's lifetime energy and the session's charged energy in kWh
+func (ce *ChargeEfficiency) Update(vehicleKWh, chargedKWh float64) {
+	if !ce.started || vehicleKWh < ce.vehicleStart || chargedKWh < ce.chargedStart {
+		ce.started = true
+		ce.vehicleStart = vehicleKWh
+		ce.chargedStart = chargedKWh
+		ce.efficiency = nil
+		return
+	}
+
+	stored := vehicleKWh - ce.vehicleStart
+	charged := chargedKWh - ce.chargedStart
+
+	// not enough data or implausible counters
+	if charged < minEfficiencyEnergy || stored <= 0 || stored > charged {
+		ce.efficiency = nil
+		return
+	}
+
+	efficiency := 100 * stored / charged
+	ce.efficiency = &efficiency
+}
+
+// Percentage returns the efficiency in percent or nil if unknown
+func (ce *ChargeEfficiency) Percentage() *float64 {
+	return ce.efficiency
+}
@@ -0,0 +1,37 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChargeEfficiency(t *testing.T) {
+	var ce ChargeEfficiency
+	assert.Nil(t, ce.Percentage())
+
+	// first reading is the reference
+	ce.Update(1000, 0)
+	assert.Nil(t, ce.Percentage())
+
+	// not enough energy charged
+	ce.Update(1000.5, 0.5)
+	assert.Nil(t, ce.Percentage())
+
+	ce.Update(1009, 10)
+	assert.InDelta(t, 90, *ce.Percentage(), 1e-6)
+
+	// implausible vehicle counter is not reported as 100%
+	ce.Update(1011, 10)
+	assert.Nil(t, ce.Percentage())
+
+	// vehicle counter reset restarts measurement
+	ce.Update(500, 12)
+	assert.Nil(t, ce.Percentage())
+
+	ce.Update(509.5, 22)
+	assert.InDelta(t, 95, *ce.Percentage(), 1e-6)
+
+	ce.Reset()
+	assert.Nil(t, ce.Percentage())
+}
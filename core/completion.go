@@ -0,0 +1,41 @@
+package core
+
+// Completion fires the charge complete event once per session.
+// It rearms on Reset, e.g. when the vehicle is disconnected, or when the soc drops threshold below the target.
+type Completion struct {
+	threshold float64
+	notified  bool
+}
+
+func NewCompletion(threshold float64) *Completion {
+	return &Completion{
+		threshold: threshold,
+	}
+}
+
+// Complete returns true if soc has reached the target for the first time since the completion was armed
+func (c *Completion) Complete(soc, target float64) bool {
+	// test guard
+	if c == nil || target <= 0 {
+		return false
+	}
+
+	if c.notified {
+		if soc < target-c.threshold {
+			c.notified = false
+		}
+
+		return false
+	}
+
+	c.notified = soc >= target
+
+	return c.notified
+}
+
+func (c *Completion) Reset() {
+	// test guard
+	if c != nil {
+		c.notified = false
+	}
+}
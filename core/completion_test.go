@@ -0,0 +1,75 @@
+package core
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/evcc-io/evcc/mock"
+	"github.com/evcc-io/evcc/push"
+	"github.com/evcc-io/evcc/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompletion(t *testing.T) {
+	c := NewCompletion(5)
+
+	tc := []struct {
+		soc float64
+		res bool
+	}{
+		{70, false},
+		{80, true},
+		// oscillating near target
+		{79, false},
+		{80, false},
+		{78, false},
+		{81, false},
+		// dropped below threshold
+		{74, false},
+		{80, true},
+	}
+
+	for _, tc := range tc {
+		require.Equal(t, tc.res, c.Complete(tc.soc, 80), fmt.Sprintf("%.0f%%", tc.soc))
+	}
+
+	// new session
+	c.Reset()
+	require.True(t, c.Complete(80, 80))
+
+	// no target
+	c.Reset()
+	require.False(t, c.Complete(80, 0))
+}
+
+func TestCompletionEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	pushChan := make(chan push.Event, 10)
+
+	lp := &Loadpoint{
+		log:        util.NewLogger("foo"),
+		vehicle:    mock.NewMockVehicle(ctrl),
+		pushChan:   pushChan,
+		completion: NewCompletion(completionThreshold),
+	}
+	lp.Soc.target = 80
+
+	for _, soc := range []float64{79, 80, 79.5, 80, 80.5, 79, 80} {
+		lp.evVehicleSocProgressHandler(soc)
+	}
+
+	// disconnect rearms completion
+	lp.completion.Reset()
+	lp.evVehicleSocProgressHandler(80)
+
+	close(pushChan)
+
+	var events []string
+	for ev := range pushChan {
+		events = append(events, ev.Event)
+	}
+
+	require.Equal(t, []string{evChargeComplete, evChargeComplete}, events)
+}
@@ -7,25 +7,35 @@ const (
 	phasesEnabled    = "phasesEnabled"    // enabled phases (1/3)
 	phasesActive     = "phasesActive"     // active phases as used by vehicle (1/2/3)
 
+	phaseSwitchCooldown = "phaseSwitchCooldown" // remaining time until automatic phase switching is allowed
+
 	chargerIcon = "chargerIcon" // charger icon for ui
 
 	vehicleCapacity        = "vehicleCapacity"        // vehicle battery capacity
+	vehicleChargeCurrent   = "vehicleChargeCurrent"   // vehicle measured charge current
 	vehicleDetectionActive = "vehicleDetectionActive" // vehicle detection active
 	vehicleIcon            = "vehicleIcon"            // vehicle icon for ui
+	vehicleNextService     = "vehicleNextService"     // vehicle odometer at which the next service is due
 	vehicleOdometer        = "vehicleOdometer"        // vehicle odometer
 	vehiclePresent         = "vehiclePresent"         // vehicle detected
 	vehicleRange           = "vehicleRange"           // vehicle range
 	vehicleSoc             = "vehicleSoc"             // vehicle soc
+	vehicleSocEstimated    = "vehicleSocEstimated"    // vehicle unreachable, soc estimated from charged energy
+	vehicleStatus          = "vehicleStatus"          // vehicle charge status
 	vehicleTargetSoc       = "vehicleTargetSoc"       // vehicle soc limit
+	vehicleTirePressure    = "vehicleTirePressure"    // vehicle tire pressures
 	vehicleTitle           = "vehicleTitle"           // vehicle title
 
 	minCurrent              = "minCurrent"              // charger min current
 	maxCurrent              = "maxCurrent"              // charger max current
 	chargeRemainingDuration = "chargeRemainingDuration" // charge remaining duration
+	sessionEfficiency       = "sessionEfficiency"       // charge efficiency of the session
 	minSoc                  = "minSoc"                  // min soc goal
 	targetEnergy            = "targetEnergy"            // target charging energy goal
 	targetSoc               = "targetSoc"               // target charging soc goal
 	targetTime              = "targetTime"              // target charging finish time goal
 	planActive              = "planActive"              // target charging plan has determined current slot to be an active slot
 	planProjectedStart      = "planProjectedStart"      // target charging plan start time (earliest slot)
+	planUnreachable         = "planUnreachable"         // target charging plan can't reach the target by target time
+	priceGate               = "priceGate"               // price gate decision and reasoning
 )
@@ -20,7 +20,11 @@ func NewAdapter(lp loadpoint.API, c *Coordinator) API {
 }
 
 func (a *adapter) GetVehicles() []api.Vehicle {
-	return a.c.GetVehicles()
+	return a.c.enabledVehicles()
+}
+
+func (a *adapter) Enabled(v api.Vehicle) bool {
+	return a.c.Enabled(v)
 }
 
 func (a *adapter) Acquire(v api.Vehicle) {
@@ -33,5 +37,5 @@ func (a *adapter) Release(v api.Vehicle) {
 
 func (a *adapter) IdentifyVehicleByStatus() api.Vehicle {
 	available := a.c.availableDetectibleVehicles(a.lp)
-	return a.c.identifyVehicleByStatus(available)
+	return a.c.identifyVehicleByStatus(a.lp, available)
 }
@@ -5,6 +5,7 @@ import "github.com/evcc-io/evcc/api"
 // API is the coordinator API
 type API interface {
 	GetVehicles() []api.Vehicle
+	Enabled(api.Vehicle) bool
 	Acquire(api.Vehicle)
 	Release(api.Vehicle)
 	IdentifyVehicleByStatus() api.Vehicle
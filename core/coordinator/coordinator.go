@@ -1,6 +1,9 @@
 package coordinator
 
 import (
+	"strings"
+	"sync"
+
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/core/loadpoint"
 	"github.com/evcc-io/evcc/util"
@@ -10,7 +13,9 @@ import (
 type Coordinator struct {
 	log      *util.Logger
 	vehicles []api.Vehicle
+	mu       sync.Mutex // guards tracked and disabled
 	tracked  map[api.Vehicle]loadpoint.API
+	disabled map[api.Vehicle]bool // vehicles disabled at runtime
 }
 
 // New creates a coordinator for a set of vehicles
@@ -19,6 +24,7 @@ func New(log *util.Logger, vehicles []api.Vehicle) *Coordinator {
 		log:      log,
 		vehicles: vehicles,
 		tracked:  make(map[api.Vehicle]loadpoint.API),
+		disabled: make(map[api.Vehicle]bool),
 	}
 }
 
@@ -26,27 +32,80 @@ func (c *Coordinator) GetVehicles() []api.Vehicle {
 	return c.vehicles
 }
 
+// Enabled returns false if the vehicle has been disabled at runtime
+func (c *Coordinator) Enabled(vehicle api.Vehicle) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return !c.disabled[vehicle]
+}
+
+// SetEnabled enables or disables the vehicle at runtime.
+// Disabled vehicles are removed from their loadpoint and are not detected anymore.
+func (c *Coordinator) SetEnabled(vehicle api.Vehicle, enable bool) {
+	c.mu.Lock()
+	if enable {
+		delete(c.disabled, vehicle)
+	} else {
+		c.disabled[vehicle] = true
+	}
+	o, ok := c.tracked[vehicle]
+	c.mu.Unlock()
+
+	// loadpoint releases the vehicle, don't hold the lock
+	if ok && !enable {
+		c.log.DEBUG.Printf("vehicle disabled: %s", vehicle.Title())
+		o.SetVehicle(nil)
+	}
+}
+
+// enabledVehicles is the list of vehicles not disabled at runtime
+func (c *Coordinator) enabledVehicles() []api.Vehicle {
+	var res []api.Vehicle
+
+	for _, vv := range c.vehicles {
+		if c.Enabled(vv) {
+			res = append(res, vv)
+		}
+	}
+
+	return res
+}
+
 func (c *Coordinator) acquire(owner loadpoint.API, vehicle api.Vehicle) {
-	if o, ok := c.tracked[vehicle]; ok && o != owner {
+	// previous owner releases the vehicle, don't hold the lock
+	if o, ok := c.owner(vehicle); ok && o != owner {
 		o.SetVehicle(nil)
 	}
+
+	c.mu.Lock()
 	c.tracked[vehicle] = owner
+	c.mu.Unlock()
 }
 
 func (c *Coordinator) release(vehicle api.Vehicle) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
 	delete(c.tracked, vehicle)
 }
 
+// owner returns the loadpoint the vehicle is associated to
+func (c *Coordinator) owner(vehicle api.Vehicle) (loadpoint.API, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	o, ok := c.tracked[vehicle]
+	return o, ok
+}
+
 // availableDetectibleVehicles is the list of vehicles that are currently not
 // associated to another loadpoint and have a status api that allows for detection
 func (c *Coordinator) availableDetectibleVehicles(owner loadpoint.API) []api.Vehicle {
 	var res []api.Vehicle
 
-	for _, vv := range c.vehicles {
+	for _, vv := range c.enabledVehicles() {
 		// status api available
 		if _, ok := vv.(api.ChargeState); ok {
 			// available or associated to current loadpoint
-			if o, ok := c.tracked[vv]; o == owner || !ok {
+			if o, ok := c.owner(vv); o == owner || !ok {
 				res = append(res, vv)
 			}
 		}
@@ -55,9 +114,25 @@ func (c *Coordinator) availableDetectibleVehicles(owner loadpoint.API) []api.Veh
 	return res
 }
 
-// identifyVehicleByStatus finds active vehicle by charge state
-func (c *Coordinator) identifyVehicleByStatus(available []api.Vehicle) api.Vehicle {
-	var res api.Vehicle
+// affinity ranks the vehicle's preference for the loadpoint:
+// preferred (2) before no preference (1) before preferring another loadpoint (0)
+func affinity(owner loadpoint.API, vehicle api.Vehicle) int {
+	vp, ok := vehicle.(api.VehiclePreferredLoadpoint)
+	if !ok || vp.PreferredLoadpoint() == "" || owner == nil {
+		return 1
+	}
+
+	if strings.EqualFold(vp.PreferredLoadpoint(), owner.Title()) {
+		return 2
+	}
+
+	return 0
+}
+
+// identifyVehicleByStatus finds active vehicle by charge state.
+// Multiple matches are resolved by the vehicles' preferred loadpoint.
+func (c *Coordinator) identifyVehicleByStatus(owner loadpoint.API, available []api.Vehicle) api.Vehicle {
+	var matches []api.Vehicle
 	for _, vehicle := range available {
 		if vs, ok := vehicle.(api.ChargeState); ok {
 			status, err := vs.Status()
@@ -68,17 +143,47 @@ func (c *Coordinator) identifyVehicleByStatus(available []api.Vehicle) api.Vehic
 
 			c.log.DEBUG.Printf("vehicle status: %s (%s)", status, vehicle.Title())
 
-			// vehicle is plugged or charging, so it should be the right one
-			if status == api.StatusB || status == api.StatusC {
-				if res != nil {
-					c.log.WARN.Println("vehicle status: >1 matches, giving up")
-					return nil
+			// vehicles known to be away from home cannot be connected
+			if vp, ok := vehicle.(api.VehiclePresent); ok {
+				if present, err := vp.Present(); err == nil && !present {
+					c.log.DEBUG.Printf("vehicle status: %s not at home", vehicle.Title())
+					continue
 				}
+			}
 
-				res = vehicle
+			// vehicle is plugged or charging, so it should be the right one
+			if status == api.StatusB || status == api.StatusC {
+				matches = append(matches, vehicle)
 			}
 		}
 	}
 
+	switch len(matches) {
+	case 0:
+		return nil
+	case 1:
+		return matches[0]
+	}
+
+	// prefer the single vehicle with highest affinity to the loadpoint
+	var res api.Vehicle
+	best, ambiguous := -1, false
+
+	for _, vehicle := range matches {
+		switch a := affinity(owner, vehicle); {
+		case a > best:
+			res, best, ambiguous = vehicle, a, false
+		case a == best:
+			ambiguous = true
+		}
+	}
+
+	if ambiguous {
+		c.log.WARN.Println("vehicle status: >1 matches, giving up")
+		return nil
+	}
+
+	c.log.DEBUG.Printf("vehicle status: >1 matches, preferring %s", res.Title())
+
 	return res
 }
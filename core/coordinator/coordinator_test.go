@@ -1,6 +1,7 @@
 package coordinator
 
 import (
+	"sync"
 	"testing"
 
 	"github.com/evcc-io/evcc/api"
@@ -8,6 +9,7 @@ import (
 	"github.com/evcc-io/evcc/mock"
 	"github.com/evcc-io/evcc/util"
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestVehicleDetectByStatus(t *testing.T) {
@@ -53,7 +55,7 @@ func TestVehicleDetectByStatus(t *testing.T) {
 		v2.MockChargeState.EXPECT().Status().Return(tc.v2, nil)
 
 		available := c.availableDetectibleVehicles(lp) // include id-able vehicles
-		res := c.identifyVehicleByStatus(available)
+		res := c.identifyVehicleByStatus(lp, available)
 		if tc.res != res {
 			t.Errorf("expected %v, got %v", tc.res, res)
 		}
@@ -65,3 +67,113 @@ func TestVehicleDetectByStatus(t *testing.T) {
 		}
 	}
 }
+
+func TestVehicleDisabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	type vehicle struct {
+		*mock.MockVehicle
+		*mock.MockChargeState
+	}
+
+	v1 := &vehicle{mock.NewMockVehicle(ctrl), mock.NewMockChargeState(ctrl)}
+	v2 := &vehicle{mock.NewMockVehicle(ctrl), mock.NewMockChargeState(ctrl)}
+	v1.MockVehicle.EXPECT().Title().Return("v1").AnyTimes()
+
+	c := New(util.NewLogger("foo"), []api.Vehicle{v1, v2})
+
+	lp := loadpoint.NewMockAPI(ctrl)
+	c.acquire(lp, v1)
+
+	// disabling removes the vehicle from its loadpoint
+	lp.EXPECT().SetVehicle(nil)
+	c.SetEnabled(v1, false)
+
+	assert.False(t, c.Enabled(v1))
+	assert.Equal(t, []api.Vehicle{v2}, c.availableDetectibleVehicles(lp))
+	assert.Equal(t, []api.Vehicle{v2}, NewAdapter(lp, c).GetVehicles())
+	assert.Len(t, c.GetVehicles(), 2)
+
+	c.SetEnabled(v1, true)
+	assert.True(t, c.Enabled(v1))
+	assert.Len(t, c.availableDetectibleVehicles(lp), 2)
+}
+
+func TestVehicleConcurrentAccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	v1 := mock.NewMockVehicle(ctrl)
+	v1.EXPECT().Title().Return("v1").AnyTimes()
+
+	c := New(util.NewLogger("foo"), []api.Vehicle{v1})
+
+	lp := loadpoint.NewMockAPI(ctrl)
+	lp.EXPECT().SetVehicle(nil).AnyTimes()
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			c.acquire(lp, v1)
+			c.release(v1)
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			c.SetEnabled(v1, i%2 == 0)
+		}
+	}()
+
+	wg.Wait()
+}
+
+type affinityVehicle struct {
+	*mock.MockVehicle
+	*mock.MockChargeState
+	preferred string
+}
+
+func (v *affinityVehicle) PreferredLoadpoint() string {
+	return v.preferred
+}
+
+func TestVehicleAffinity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	v1 := &affinityVehicle{MockVehicle: mock.NewMockVehicle(ctrl), MockChargeState: mock.NewMockChargeState(ctrl)}
+	v2 := &affinityVehicle{MockVehicle: mock.NewMockVehicle(ctrl), MockChargeState: mock.NewMockChargeState(ctrl)}
+	v1.MockVehicle.EXPECT().Title().Return("v1").AnyTimes()
+	v2.MockVehicle.EXPECT().Title().Return("v2").AnyTimes()
+
+	lp := loadpoint.NewMockAPI(ctrl)
+	lp.EXPECT().Title().Return("Garage").AnyTimes()
+
+	tc := []struct {
+		string
+		v1, v2       api.ChargeStatus
+		pref1, pref2 string
+		res          api.Vehicle
+	}{
+		{"preferred wins", api.StatusB, api.StatusB, "garage", "", v1},
+		{"other loadpoint loses", api.StatusB, api.StatusC, "carport", "", v2},
+		{"both preferred", api.StatusB, api.StatusB, "garage", "garage", nil},
+		{"no preference", api.StatusB, api.StatusB, "", "", nil},
+		{"both other loadpoint", api.StatusB, api.StatusB, "carport", "carport", nil},
+		{"single match ignores preference", api.StatusA, api.StatusB, "garage", "carport", v2},
+	}
+
+	c := New(util.NewLogger("foo"), []api.Vehicle{v1, v2})
+
+	for _, tc := range tc {
+		v1.preferred, v2.preferred = tc.pref1, tc.pref2
+		v1.MockChargeState.EXPECT().Status().Return(tc.v1, nil)
+		v2.MockChargeState.EXPECT().Status().Return(tc.v2, nil)
+
+		res := c.identifyVehicleByStatus(lp, []api.Vehicle{v1, v2})
+		assert.True(t, tc.res == res, tc.string)
+	}
+}
@@ -15,6 +15,10 @@ func (a *dummy) GetVehicles() []api.Vehicle {
 	return nil
 }
 
+func (a *dummy) Enabled(v api.Vehicle) bool {
+	return true
+}
+
 func (a *dummy) Acquire(v api.Vehicle) {}
 
 func (a *dummy) Release(v api.Vehicle) {}
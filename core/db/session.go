@@ -34,6 +34,7 @@ type Session struct {
 	Price           *float64  `json:"price" csv:"Price" gorm:"column:price"`
 	PricePerKWh     *float64  `json:"pricePerKWh" csv:"Price/kWh" gorm:"column:price_per_kwh"`
 	Co2PerKWh       *float64  `json:"co2PerKWh" csv:"CO2/kWh (gCO2eq)" gorm:"column:co2_per_kwh"`
+	Efficiency      *float64  `json:"efficiency" csv:"Efficiency (%)" gorm:"column:efficiency"`
 }
 
 // Sessions is a list of sessions
@@ -0,0 +1,203 @@
+package history
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/api"
+)
+
+// Sample is a single vehicle telemetry reading
+type Sample struct {
+	Timestamp time.Time `json:"timestamp"`
+	Vehicle   string    `json:"vehicle"`
+	Soc       *float64  `json:"soc,omitempty"`
+	Range     *int64    `json:"range,omitempty"`
+	Odometer  *float64  `json:"odometer,omitempty"`
+}
+
+// Samples is a list of samples ordered by time
+type Samples []Sample
+
+var _ api.CsvWriter = (*Samples)(nil)
+
+// WriteCsv implements the api.CsvWriter interface
+func (s *Samples) WriteCsv(ctx context.Context, w io.Writer) error {
+	ww := csv.NewWriter(w)
+
+	if err := ww.Write([]string{"Timestamp", "Vehicle", "Soc (%)", "Range (km)", "Odometer (km)"}); err != nil {
+		return err
+	}
+
+	for _, r := range *s {
+		row := []string{r.Timestamp.Local().Format("2006-01-02 15:04:05"), r.Vehicle, "", "", ""}
+
+		if r.Soc != nil {
+			row[2] = strconv.FormatFloat(*r.Soc, 'f', -1, 64)
+		}
+		if r.Range != nil {
+			row[3] = strconv.FormatInt(*r.Range, 10)
+		}
+		if r.Odometer != nil {
+			row[4] = strconv.FormatFloat(*r.Odometer, 'f', -1, 64)
+		}
+
+		if err := ww.Write(row); err != nil {
+			return err
+		}
+	}
+
+	ww.Flush()
+	return ww.Error()
+}
+
+// Recorder keeps a bounded history of vehicle telemetry in a ring buffer per vehicle
+type Recorder struct {
+	mu        sync.Mutex
+	clock     clock.Clock
+	interval  time.Duration
+	retention time.Duration
+	size      int // ring buffer capacity per vehicle
+	buffers   map[string]*ring
+}
+
+// ring is a fixed size buffer overwriting the oldest sample once full
+type ring struct {
+	samples []Sample
+	next    int
+	full    bool
+}
+
+func (r *ring) add(s Sample) {
+	r.samples[r.next] = s
+	r.next = (r.next + 1) % len(r.samples)
+	if r.next == 0 {
+		r.full = true
+	}
+}
+
+// all returns the samples in chronological order
+func (r *ring) all() []Sample {
+	if !r.full {
+		return r.samples[:r.next]
+	}
+	return append(r.samples[r.next:len(r.samples):len(r.samples)], r.samples[:r.next]...)
+}
+
+// New creates a recorder sampling at the given interval and keeping samples for the retention period
+func New(interval, retention time.Duration) *Recorder {
+	size := 1
+	if interval > 0 {
+		size += int(retention / interval)
+	}
+
+	return &Recorder{
+		clock:     clock.New(),
+		interval:  interval,
+		retention: retention,
+		size:      size,
+		buffers:   make(map[string]*ring),
+	}
+}
+
+// Run samples the vehicles at the recorder's interval until the context is cancelled
+func (r *Recorder) Run(ctx context.Context, vehicles func() []api.Vehicle) {
+	if r.interval <= 0 || r.retention <= 0 {
+		return
+	}
+
+	ticker := r.clock.Ticker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		for _, v := range vehicles() {
+			r.Record(v)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Record samples the vehicle's soc, range and odometer if available
+func (r *Recorder) Record(v api.Vehicle) {
+	s := Sample{
+		Timestamp: r.clock.Now(),
+		Vehicle:   api.VehicleName(v),
+	}
+
+	if soc, err := v.Soc(); err == nil {
+		s.Soc = &soc
+	}
+
+	if vv, ok := v.(api.VehicleRange); ok {
+		if rng, err := vv.Range(); err == nil {
+			s.Range = &rng
+		}
+	}
+
+	if vv, ok := v.(api.VehicleOdometer); ok {
+		if odo, err := vv.Odometer(); err == nil {
+			s.Odometer = &odo
+		}
+	}
+
+	// nothing to record
+	if s.Soc == nil && s.Range == nil && s.Odometer == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b, ok := r.buffers[s.Vehicle]
+	if !ok {
+		b = &ring{samples: make([]Sample, r.size)}
+		r.buffers[s.Vehicle] = b
+	}
+
+	b.add(s)
+}
+
+// Export returns the samples of the given vehicle or of all vehicles if empty within the time window.
+// Samples older than the retention period are omitted.
+func (r *Recorder) Export(vehicle string, from, to time.Time) Samples {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if oldest := r.clock.Now().Add(-r.retention); from.Before(oldest) {
+		from = oldest
+	}
+
+	res := make(Samples, 0)
+
+	for name, b := range r.buffers {
+		if vehicle != "" && name != vehicle {
+			continue
+		}
+
+		for _, s := range b.all() {
+			if !s.Timestamp.Before(from) && !s.Timestamp.After(to) {
+				res = append(res, s)
+			}
+		}
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Timestamp.Equal(res[j].Timestamp) {
+			return res[i].Vehicle < res[j].Vehicle
+		}
+		return res[i].Timestamp.Before(res[j].Timestamp)
+	})
+
+	return res
+}
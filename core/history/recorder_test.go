@@ -0,0 +1,42 @@
+package history
+
+import (
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecorderRetention(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	v := mock.NewMockVehicle(ctrl)
+	v.EXPECT().Title().Return("car").AnyTimes()
+
+	clock := clock.NewMock()
+	r := New(time.Minute, 2*time.Minute)
+	r.clock = clock
+
+	for i := 0; i < 5; i++ {
+		v.EXPECT().Soc().Return(float64(i), nil)
+		r.Record(v)
+		clock.Add(30 * time.Second)
+	}
+
+	// ring buffer keeps the most recent samples
+	res := r.Export("", time.Time{}, clock.Now())
+	assert.Len(t, res, 3)
+
+	for i, s := range res {
+		assert.Equal(t, float64(i+2), *s.Soc)
+	}
+
+	// time window
+	res = r.Export("car", clock.Now().Add(-time.Minute), clock.Now())
+	assert.Len(t, res, 2)
+
+	assert.Empty(t, r.Export("other", time.Time{}, clock.Now()))
+}
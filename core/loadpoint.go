@@ -14,6 +14,7 @@ import (
 	"github.com/evcc-io/evcc/core/db"
 	"github.com/evcc-io/evcc/core/loadpoint"
 	"github.com/evcc-io/evcc/core/planner"
+	"github.com/evcc-io/evcc/core/pricegate"
 	"github.com/evcc-io/evcc/core/soc"
 	"github.com/evcc-io/evcc/core/wrapper"
 	"github.com/evcc-io/evcc/provider"
@@ -27,14 +28,18 @@ import (
 )
 
 const (
-	evChargeStart         = "start"      // update chargeTimer
-	evChargeStop          = "stop"       // update chargeTimer
-	evChargeCurrent       = "current"    // update fakeChargeMeter
-	evChargePower         = "power"      // update chargeRater
-	evVehicleConnect      = "connect"    // vehicle connected
-	evVehicleDisconnect   = "disconnect" // vehicle disconnected
-	evVehicleSoc          = "soc"        // vehicle soc progress
-	evVehicleUnidentified = "guest"      // vehicle unidentified
+	evChargeStart         = "start"         // update chargeTimer
+	evChargeStop          = "stop"          // update chargeTimer
+	evChargeCurrent       = "current"       // update fakeChargeMeter
+	evChargePower         = "power"         // update chargeRater
+	evVehicleConnect      = "connect"       // vehicle connected
+	evVehicleDisconnect   = "disconnect"    // vehicle disconnected
+	evVehicleSoc          = "soc"           // vehicle soc progress
+	evVehicleUnidentified = "guest"         // vehicle unidentified
+	evVehicleStatus       = "vehiclestatus" // vehicle charge status changed
+	evVehiclePlugIn       = "plugin"        // vehicle reports being plugged in
+	evChargeComplete      = "complete"      // vehicle soc reached target
+	evVehicleService      = "service"       // vehicle odometer crossed service threshold
 
 	pvTimer   = "pv"
 	pvEnable  = "enable"
@@ -54,6 +59,9 @@ const (
 
 	guardGracePeriod    = 60 * time.Second // allow out of sync during this timespan
 	phaseSwitchDuration = 60 * time.Second // do not measure phases during this timespan
+
+	targetSocHysteresis = 2.0 // soc below target at which charging resumes after target was reached
+	completionThreshold = 5.0 // soc below target at which the charge complete event is sent again
 )
 
 // elapsed is the time an expired timer will be set to
@@ -73,6 +81,9 @@ type SocConfig struct {
 	Target_  int        `mapstructure:"target"` // TODO deprecated
 	min      int        // Default minimum Soc, guarded by mutex
 	target   int        // Default target Soc, guarded by mutex
+
+	Source string        `mapstructure:"source"` // soc precedence: vehicle, estimate or auto
+	MaxAge time.Duration `mapstructure:"maxAge"` // vehicle soc age after which auto falls back to the estimate
 }
 
 // Poll modes
@@ -84,6 +95,15 @@ const (
 	pollInterval = 60 * time.Minute
 )
 
+// Soc sources
+const (
+	socSourceVehicle  = "vehicle"  // vehicle soc, even if outdated
+	socSourceEstimate = "estimate" // soc estimated from energy charged since the last vehicle soc
+	socSourceAuto     = "auto"     // vehicle soc unless older than max age
+
+	socMaxAge = 30 * time.Minute
+)
+
 // ThresholdConfig defines enable/disable hysteresis parameters
 type ThresholdConfig struct {
 	Delay     time.Duration
@@ -102,6 +122,7 @@ type Loadpoint struct {
 	uiChan   chan<- util.Param // client push messages
 	lpChan   chan<- *Loadpoint // update requests
 	log      *util.Logger
+	settings string // settings key prefix of the loadpoint
 
 	// exposed public configuration
 	sync.Mutex                // guard status
@@ -125,6 +146,10 @@ type Loadpoint struct {
 	MaxCurrent    float64       // Max allowed current. Physically ensured by the charger
 	GuardDuration time.Duration // charger enable/disable minimum holding time
 
+	PhaseSwitchDelay time.Duration `mapstructure:"phaseSwitchDelay"` // minimum time between automatic phase switches, manual switching is not affected
+
+	PriceGate pricegate.Config `mapstructure:"priceGate"` // grid charging above price limit only below soc floor
+
 	enabled             bool      // Charger enabled state
 	phases              int       // Charger enabled phases, guarded by mutex
 	measuredPhases      int       // Charger physically measured phases
@@ -135,6 +160,8 @@ type Loadpoint struct {
 	phasesSwitched      time.Time // Phase switch timestamp
 	vehicleDetectTicker *clock.Ticker
 	vehicleIdentifier   string
+	vehicleVIN          string           // vehicle identification number reported by the charger
+	vehicleStatus       api.ChargeStatus // last known vehicle charge status
 
 	charger          api.Charger
 	chargeTimer      api.ChargeTimer
@@ -148,10 +175,13 @@ type Loadpoint struct {
 	socEstimator   *soc.Estimator
 
 	// target charging
-	planner     *planner.Planner
-	targetTime  time.Time // time goal
-	planSlotEnd time.Time // current plan slot end time
-	planActive  bool      // plan is active
+	planner         *planner.Planner
+	targetTime      time.Time // time goal
+	planSlotEnd     time.Time // current plan slot end time
+	planActive      bool      // plan is active
+	planUnreachable bool      // target time can't be met, charging immediately
+
+	priceGate *pricegate.Gate // grid charging by price and vehicle soc, nil if disabled
 
 	// cached state
 	status         api.ChargeStatus       // Charger status
@@ -165,16 +195,22 @@ type Loadpoint struct {
 
 	// charge progress
 	vehicleSoc              float64        // Vehicle Soc
+	vehicleRange            int64          // Vehicle range in km
 	chargeDuration          time.Duration  // Charge duration
 	sessionEnergy           *EnergyMetrics // Stats for charged energy by session
 	chargeRemainingDuration time.Duration  // Remaining charge duration
 	chargeRemainingEnergy   float64        // Remaining charge energy in Wh
 	progress                *Progress      // Step-wise progress indicator
+	targetSocStopped        bool           // Target soc reached, charging resumes below hysteresis
+	targetSocDisabled       bool           // Charger disabled at target soc in a previous cycle
+	completion              *Completion    // Charge complete event deduplication
 
 	// session log
 	db      db.Database
 	session *db.Session
 
+	chargeEfficiency ChargeEfficiency // vehicle battery vs charged energy of the session
+
 	tasks *util.Queue[Task] // tasks to be executed
 }
 
@@ -213,6 +249,12 @@ func NewLoadpointFromConfig(log *util.Logger, cp configProvider, other map[strin
 		lp.log.WARN.Println("Configuring soc.target at loadpoint is deprecated and must be applied per vehicle")
 	}
 
+	switch lp.Soc.Source = strings.ToLower(lp.Soc.Source); lp.Soc.Source {
+	case "", socSourceVehicle, socSourceEstimate, socSourceAuto:
+	default:
+		return nil, fmt.Errorf("invalid soc source: %s", lp.Soc.Source)
+	}
+
 	// store defaults
 	lp.collectDefaults()
 
@@ -288,14 +330,17 @@ func NewLoadpoint(log *util.Logger) *Loadpoint {
 			},
 			min:    0,   // %
 			target: 100, // %
+			MaxAge: socMaxAge,
 		},
-		Enable:        ThresholdConfig{Delay: time.Minute, Threshold: 0},     // t, W
-		Disable:       ThresholdConfig{Delay: 3 * time.Minute, Threshold: 0}, // t, W
-		GuardDuration: 5 * time.Minute,
-		sessionEnergy: NewEnergyMetrics(),
-		progress:      NewProgress(0, 10),     // soc progress indicator
-		coordinator:   coordinator.NewDummy(), // dummy vehicle coordinator
-		tasks:         util.NewQueue[Task](),  // task queue
+		Enable:           ThresholdConfig{Delay: time.Minute, Threshold: 0},     // t, W
+		Disable:          ThresholdConfig{Delay: 3 * time.Minute, Threshold: 0}, // t, W
+		GuardDuration:    5 * time.Minute,
+		PhaseSwitchDelay: 5 * time.Minute,
+		sessionEnergy:    NewEnergyMetrics(),
+		progress:         NewProgress(0, 10),     // soc progress indicator
+		coordinator:      coordinator.NewDummy(), // dummy vehicle coordinator
+		tasks:            util.NewQueue[Task](),  // task queue
+		completion:       NewCompletion(completionThreshold),
 	}
 
 	return lp
@@ -439,6 +484,7 @@ func (lp *Loadpoint) evVehicleConnectHandler() {
 	lp.sessionEnergy.Reset()
 	lp.sessionEnergy.Publish("session", lp)
 	lp.publish("chargedEnergy", lp.getChargedEnergy())
+	lp.resetChargeEfficiency()
 
 	// duration
 	lp.connectedTime = lp.clock.Now()
@@ -482,8 +528,19 @@ func (lp *Loadpoint) evVehicleDisconnectHandler() {
 	// forget startup energy offset
 	lp.chargedAtStartup = 0
 
+	// efficiency has been persisted with the session
+	lp.resetChargeEfficiency()
+
+	// next vehicle starts charging regardless of hysteresis
+	lp.targetSocStopped = false
+	lp.targetSocDisabled = false
+
+	// next session notifies completion again
+	lp.completion.Reset()
+
 	// remove charger vehicle id and stop potential detection
 	lp.setVehicleIdentifier("")
+	lp.vehicleVIN = ""
 	lp.stopVehicleDetection()
 
 	// set default vehicle (may be nil)
@@ -512,6 +569,35 @@ func (lp *Loadpoint) evVehicleSocProgressHandler(soc float64) {
 	if lp.progress.NextStep(soc) {
 		lp.pushEvent(evVehicleSoc)
 	}
+
+	// notify once per session even if soc oscillates around the target
+	if lp.vehicle != nil && lp.completion.Complete(soc, float64(lp.Soc.target)) {
+		lp.log.INFO.Printf("charge complete: %.0f%%", soc)
+		lp.pushEvent(evChargeComplete)
+	}
+}
+
+// evVehicleStatusHandler sends external vehicle status event
+func (lp *Loadpoint) evVehicleStatusHandler(ev VehicleStatusChanged) {
+	lp.log.DEBUG.Printf("vehicle status: %s -> %s (%s)", ev.From, ev.To, ev.Vehicle)
+	lp.publish(vehicleStatus, ev.To)
+
+	// initial status of newly activated vehicle is no change
+	if ev.From != api.StatusNone {
+		lp.pushEvent(evVehicleStatus)
+	}
+}
+
+// evVehiclePlugInHandler starts session accounting when the vehicle reports being plugged in
+func (lp *Loadpoint) evVehiclePlugInHandler(ev VehicleStatusChanged) {
+	lp.log.INFO.Printf("vehicle plugged in: %s", ev.Vehicle)
+
+	// session already created on charger connect
+	if lp.session != nil {
+		return
+	}
+
+	lp.createSession()
 }
 
 // evChargeCurrentHandler publishes the charge current
@@ -551,10 +637,10 @@ func (lp *Loadpoint) applyAction(actionCfg api.ActionConfig) {
 		lp.SetMaxCurrent(*max)
 	}
 	if actionCfg.MinSoc != nil {
-		lp.SetMinSoc(*actionCfg.MinSoc)
+		lp.applyMinSoc(*actionCfg.MinSoc)
 	}
 	if actionCfg.TargetSoc != nil {
-		lp.SetTargetSoc(*actionCfg.TargetSoc)
+		lp.applyTargetSoc(*actionCfg.TargetSoc)
 	}
 }
 
@@ -571,6 +657,8 @@ func (lp *Loadpoint) Prepare(uiChan chan<- util.Param, pushChan chan<- push.Even
 	_ = lp.bus.Subscribe(evVehicleDisconnect, lp.evVehicleDisconnectHandler)
 	_ = lp.bus.Subscribe(evChargeCurrent, lp.evChargeCurrentHandler)
 	_ = lp.bus.Subscribe(evVehicleSoc, lp.evVehicleSocProgressHandler)
+	_ = lp.bus.Subscribe(evVehicleStatus, lp.evVehicleStatusHandler)
+	_ = lp.bus.Subscribe(evVehiclePlugIn, lp.evVehiclePlugInHandler)
 
 	// publish initial values
 	lp.publish(title, lp.Title())
@@ -625,8 +713,23 @@ func (lp *Loadpoint) Prepare(uiChan chan<- util.Param, pushChan chan<- push.Even
 	}
 }
 
+// chargerEnable enables or disables the charger unless in dry run mode
+func (lp *Loadpoint) chargerEnable(enable bool) error {
+	if util.DryRun {
+		lp.log.INFO.Printf("dry run: charger %s", status[enable])
+		return nil
+	}
+
+	return lp.charger.Enable(enable)
+}
+
 // syncCharger updates charger status and synchronizes it with expectations
 func (lp *Loadpoint) syncCharger() error {
+	// charger state is not changed in dry run mode
+	if util.DryRun {
+		return nil
+	}
+
 	enabled, err := lp.charger.Enabled()
 	if err != nil {
 		return err
@@ -650,6 +753,35 @@ func (lp *Loadpoint) syncCharger() error {
 	return nil
 }
 
+// setMaxCurrent applies the charge current unless in dry run mode. Charger current control takes precedence.
+// Vehicle-side current control is used if the charger can only switch on/off.
+func (lp *Loadpoint) setMaxCurrent(current float64) error {
+	if util.DryRun {
+		lp.log.INFO.Printf("dry run: max charge current %.3gA", current)
+		return nil
+	}
+
+	var err error
+	if charger, ok := lp.charger.(api.ChargerEx); ok {
+		err = charger.MaxCurrentMillis(current)
+	} else {
+		err = lp.charger.MaxCurrent(int64(current))
+	}
+
+	if !errors.Is(err, api.ErrNotAvailable) {
+		return err
+	}
+
+	if v, ok := lp.GetVehicle().(api.VehicleCurrentController); ok {
+		if err := v.MaxCurrent(current); !errors.Is(err, api.ErrNotAvailable) {
+			return err
+		}
+	}
+
+	// current control not available, charger is only switched on/off
+	return nil
+}
+
 // setLimit applies charger current limits and enables/disables accordingly
 func (lp *Loadpoint) setLimit(chargeCurrent float64, force bool) error {
 	// full amps only?
@@ -659,14 +791,7 @@ func (lp *Loadpoint) setLimit(chargeCurrent float64, force bool) error {
 
 	// set current
 	if chargeCurrent != lp.chargeCurrent && chargeCurrent >= lp.GetMinCurrent() {
-		var err error
-		if charger, ok := lp.charger.(api.ChargerEx); ok {
-			err = charger.MaxCurrentMillis(chargeCurrent)
-		} else {
-			err = lp.charger.MaxCurrent(int64(chargeCurrent))
-		}
-
-		if err != nil {
+		if err := lp.setMaxCurrent(chargeCurrent); err != nil {
 			return fmt.Errorf("max charge current %.3gA: %w", chargeCurrent, err)
 		}
 
@@ -683,7 +808,7 @@ func (lp *Loadpoint) setLimit(chargeCurrent float64, force bool) error {
 		}
 		lp.elapseGuard()
 
-		if err := lp.charger.Enable(enabled); err != nil {
+		if err := lp.chargerEnable(enabled); err != nil {
 			return fmt.Errorf("charger %s: %w", status[enabled], err)
 		}
 
@@ -735,12 +860,56 @@ func (lp *Loadpoint) targetEnergyReached() bool {
 }
 
 // targetSocReached checks if target is configured and reached.
+// Once reached, it remains reached until soc drops below the hysteresis band to avoid oscillation near the limit.
 // If vehicle is not configured this will always return false
 func (lp *Loadpoint) targetSocReached() bool {
-	return lp.vehicle != nil &&
-		lp.Soc.target > 0 &&
-		lp.Soc.target < 100 &&
-		lp.vehicleSoc >= float64(lp.Soc.target)
+	if lp.vehicle == nil || lp.Soc.target <= 0 || lp.Soc.target >= 100 {
+		lp.targetSocStopped = false
+		lp.targetSocDisabled = false
+		return false
+	}
+
+	target := float64(lp.Soc.target)
+
+	// reserve for the range buffer is charged on top while departure is planned
+	if !lp.targetTime.IsZero() {
+		target = float64(lp.rangeBufferTargetSoc(lp.vehicle, lp.Soc.target))
+	}
+
+	if lp.targetSocStopped {
+		target -= targetSocHysteresis
+	}
+
+	lp.targetSocStopped = lp.vehicleSoc >= target
+	if !lp.targetSocStopped {
+		lp.targetSocDisabled = false
+	}
+
+	return lp.targetSocStopped
+}
+
+// stopVehicleCharge stops charging on the vehicle side if supported unless in dry run mode
+func (lp *Loadpoint) stopVehicleCharge() {
+	if util.DryRun {
+		lp.log.INFO.Println("dry run: vehicle stop charge")
+		return
+	}
+
+	if vc, ok := lp.GetVehicle().(api.VehicleChargeController); ok {
+		if err := vc.StopCharge(); err != nil && !errors.Is(err, api.ErrNotAvailable) {
+			lp.log.ERROR.Printf("vehicle stop charge: %v", err)
+		}
+	}
+}
+
+// stopVehicleChargeIfCharging stops charging on the vehicle side if charging continues
+// in a cycle after the charger has already been disabled at target soc
+func (lp *Loadpoint) stopVehicleChargeIfCharging() {
+	if lp.targetSocDisabled && lp.charging() {
+		lp.stopVehicleCharge()
+	}
+
+	lp.targetSocDisabled = true
 }
 
 // minSocNotReached checks if minimum is configured and not reached.
@@ -924,7 +1093,9 @@ func (lp *Loadpoint) scalePhases(phases int) error {
 
 	if lp.GetPhases() != phases {
 		// switch phases
-		if err := cp.Phases1p3p(phases); err != nil {
+		if util.DryRun {
+			lp.log.INFO.Printf("dry run: switch phases %dp", phases)
+		} else if err := cp.Phases1p3p(phases); err != nil {
 			return fmt.Errorf("switch phases: %w", err)
 		}
 
@@ -947,6 +1118,19 @@ func (lp *Loadpoint) fastCharging() error {
 	return err
 }
 
+// phaseSwitchCooldown returns the remaining time until automatic phase switching is allowed again
+func (lp *Loadpoint) phaseSwitchCooldown() time.Duration {
+	if lp.phasesSwitched.IsZero() {
+		return 0
+	}
+
+	if remaining := lp.PhaseSwitchDelay - lp.clock.Since(lp.phasesSwitched); remaining > 0 {
+		return remaining
+	}
+
+	return 0
+}
+
 // pvScalePhases switches phases if necessary and returns if switch occurred
 func (lp *Loadpoint) pvScalePhases(availablePower, minCurrent, maxCurrent float64) bool {
 	phases := lp.GetPhases()
@@ -963,6 +1147,15 @@ func (lp *Loadpoint) pvScalePhases(availablePower, minCurrent, maxCurrent float6
 		lp.resetMeasuredPhases()
 	}
 
+	// prevent relay chatter by enforcing minimum time between phase switches
+	remaining := lp.phaseSwitchCooldown()
+	lp.publish(phaseSwitchCooldown, remaining)
+
+	if remaining > 0 {
+		lp.log.DEBUG.Printf("phase switch cooldown: %v remaining", remaining.Round(time.Second))
+		return false
+	}
+
 	var waiting bool
 	activePhases := lp.activePhases()
 
@@ -1256,7 +1449,18 @@ func (lp *Loadpoint) updateChargeVoltages() {
 
 // publish charged energy and duration
 func (lp *Loadpoint) publishChargeProgress() {
-	if f, err := lp.chargeRater.ChargedEnergy(); err == nil {
+	f, err := lp.chargeRater.ChargedEnergy()
+
+	// prefer vehicle energy counter if charger has no meter
+	if _, ok := lp.chargeMeter.(*wrapper.ChargeMeter); ok {
+		if vr, ok := lp.GetVehicle().(api.ChargeRater); ok {
+			if vf, verr := vr.ChargedEnergy(); verr == nil {
+				f, err = vf, nil
+			}
+		}
+	}
+
+	if err == nil {
 		// workaround for Go-E resetting during disconnect, see
 		// https://github.com/evcc-io/evcc/issues/5092
 		if f > lp.chargedAtStartup {
@@ -1266,7 +1470,18 @@ func (lp *Loadpoint) publishChargeProgress() {
 		lp.log.ERROR.Printf("charge rater: %v", err)
 	}
 
-	if d, err := lp.chargeTimer.ChargingTime(); err == nil {
+	d, err := lp.chargeTimer.ChargingTime()
+
+	// prefer vehicle charge timer while charging if charger has none
+	if _, ok := lp.chargeTimer.(*wrapper.ChargeTimer); ok && lp.charging() {
+		if vt, ok := lp.GetVehicle().(api.ChargeTimer); ok {
+			if vd, verr := vt.ChargingTime(); verr == nil {
+				d, err = vd, nil
+			}
+		}
+	}
+
+	if err == nil {
 		lp.chargeDuration = d.Round(time.Second)
 	} else {
 		lp.log.ERROR.Printf("charge timer: %v", err)
@@ -1311,9 +1526,12 @@ func (lp *Loadpoint) publishSocAndRange() {
 			return
 		}
 
+		f, estimated := lp.selectSoc(f)
+
 		lp.vehicleSoc = f
 		lp.log.DEBUG.Printf("vehicle soc: %.0f%%", lp.vehicleSoc)
 		lp.publish(vehicleSoc, lp.vehicleSoc)
+		lp.publish(vehicleSocEstimated, estimated)
 
 		// vehicle target soc
 		targetSoc := 100
@@ -1322,7 +1540,7 @@ func (lp *Loadpoint) publishSocAndRange() {
 				targetSoc = int(math.Trunc(limit))
 				lp.log.DEBUG.Printf("vehicle soc limit: %.0f%%", limit)
 				lp.publish(vehicleTargetSoc, limit)
-			} else {
+			} else if !errors.Is(err, api.ErrNotAvailable) {
 				lp.log.ERROR.Printf("vehicle soc limit: %v", err)
 			}
 		}
@@ -1345,12 +1563,29 @@ func (lp *Loadpoint) publishSocAndRange() {
 		if vs, ok := lp.GetVehicle().(api.VehicleRange); ok {
 			if rng, err := vs.Range(); err == nil {
 				lp.log.DEBUG.Printf("vehicle range: %dkm", rng)
+				lp.vehicleRange = rng
 				lp.publish(vehicleRange, rng)
 			} else {
 				lp.log.ERROR.Printf("vehicle range: %v", err)
 			}
 		}
 
+		// efficiency
+		lp.updateChargeEfficiency()
+
+		// position
+		if lat, lon, ok := lp.vehiclePosition(); ok {
+			lp.log.DEBUG.Printf("vehicle position: %.5f,%.5f", lat, lon)
+		}
+
+		// charge status
+		lp.updateVehicleStatus()
+
+		// charge current
+		if lp.charging() {
+			lp.checkVehicleCurrent()
+		}
+
 		// trigger message after variables are updated
 		lp.bus.Publish(evVehicleSoc, f)
 	}
@@ -1486,6 +1721,14 @@ func (lp *Loadpoint) Update(sitePower float64, autoCharge, batteryBuffered, batt
 		lp.log.DEBUG.Printf("targetSoc reached: %.1f%% > %d%%", lp.vehicleSoc, lp.Soc.target)
 		err = lp.disableUnlessClimater()
 
+		if err == nil && !lp.enabled {
+			lp.stopVehicleChargeIfCharging()
+		}
+
+	case !lp.vehicleAvailable():
+		lp.log.DEBUG.Println("vehicle not available")
+		err = lp.setLimit(0, true)
+
 	case lp.remoteControlled(loadpoint.RemoteHardDisable):
 		remoteDisabled = loadpoint.RemoteHardDisable
 		fallthrough
@@ -1497,6 +1740,13 @@ func (lp *Loadpoint) Update(sitePower float64, autoCharge, batteryBuffered, batt
 	case mode == api.ModeNow:
 		err = lp.fastCharging()
 
+	// vehicle soc floor overrides surplus and price
+	case lp.vehicleMinSocNotReached():
+		lp.log.DEBUG.Printf("vehicle minSoc not reached: %.1f%%", lp.vehicleSoc)
+		err = lp.fastCharging()
+		lp.resetPhaseTimer()
+		lp.elapsePVTimer() // let PV mode disable immediately afterwards
+
 	// minimum or target charging
 	case lp.minSocNotReached() || lp.plannerActive():
 		err = lp.fastCharging()
@@ -1512,6 +1762,14 @@ func (lp *Loadpoint) Update(sitePower float64, autoCharge, batteryBuffered, batt
 			break
 		}
 
+		// cheap price or vehicle below guaranteed soc
+		if lp.priceGateOpen() {
+			err = lp.fastCharging()
+			lp.resetPhaseTimer()
+			lp.elapsePVTimer() // let PV mode disable immediately afterwards
+			break
+		}
+
 		targetCurrent := lp.pvMaxCurrent(mode, sitePower, batteryBuffered, batteryStart)
 
 		var required bool // false
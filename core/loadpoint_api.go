@@ -112,11 +112,18 @@ func (lp *Loadpoint) GetTargetSoc() int {
 // setTargetSoc sets loadpoint charge target soc (no mutex)
 func (lp *Loadpoint) setTargetSoc(soc int) {
 	lp.Soc.target = soc
+	lp.targetSocStopped = false
 	lp.publish(targetSoc, soc)
 }
 
-// SetTargetSoc sets loadpoint charge target soc
+// SetTargetSoc sets loadpoint charge target soc and stores it for the active vehicle
 func (lp *Loadpoint) SetTargetSoc(soc int) {
+	lp.applyTargetSoc(soc)
+	lp.saveVehicleSetting(targetSoc, soc)
+}
+
+// applyTargetSoc sets loadpoint charge target soc without storing it
+func (lp *Loadpoint) applyTargetSoc(soc int) {
 	lp.Lock()
 	defer lp.Unlock()
 
@@ -142,8 +149,14 @@ func (lp *Loadpoint) setMinSoc(soc int) {
 	lp.publish(minSoc, soc)
 }
 
-// SetMinSoc sets loadpoint charge minimum soc
+// SetMinSoc sets loadpoint charge minimum soc and stores it for the active vehicle
 func (lp *Loadpoint) SetMinSoc(soc int) {
+	lp.applyMinSoc(soc)
+	lp.saveVehicleSetting(minSoc, soc)
+}
+
+// applyMinSoc sets loadpoint charge minimum soc without storing it
+func (lp *Loadpoint) applyMinSoc(soc int) {
 	lp.Lock()
 	defer lp.Unlock()
 
@@ -212,6 +225,7 @@ func (lp *Loadpoint) SetTargetTime(finishAt time.Time) error {
 func (lp *Loadpoint) setTargetTime(finishAt time.Time) {
 	lp.targetTime = finishAt
 	lp.publish(targetTime, finishAt)
+	lp.pushVehicleSchedule(finishAt, lp.Soc.target)
 
 	// TODO planActive is not guarded by mutex
 	if finishAt.IsZero() {
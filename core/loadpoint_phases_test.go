@@ -427,3 +427,29 @@ func TestScalePhasesIfAvailable(t *testing.T) {
 		ctrl.Finish()
 	}
 }
+
+func TestPhaseSwitchCooldown(t *testing.T) {
+	clck := clock.NewMock()
+
+	lp := NewLoadpoint(util.NewLogger("foo"))
+	lp.clock = clck
+	lp.phasesSwitched = clck.Now()
+
+	// 5m cooldown by default
+	clck.Add(time.Minute)
+	if d := lp.phaseSwitchCooldown(); d != 4*time.Minute {
+		t.Errorf("expected 4m cooldown, got %v", d)
+	}
+
+	clck.Add(4 * time.Minute)
+	if d := lp.phaseSwitchCooldown(); d != 0 {
+		t.Errorf("expected no cooldown, got %v", d)
+	}
+
+	// disabled if zero
+	lp.PhaseSwitchDelay = 0
+	lp.phasesSwitched = clck.Now()
+	if d := lp.phaseSwitchCooldown(); d != 0 {
+		t.Errorf("expected no cooldown, got %v", d)
+	}
+}
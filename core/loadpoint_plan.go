@@ -1,6 +1,8 @@
 package core
 
 import (
+	"errors"
+	"math"
 	"time"
 
 	"github.com/evcc-io/evcc/api"
@@ -13,6 +15,15 @@ const (
 	smallGapDuration  = 60 * time.Minute // small gap duration between planner slots we might ignore
 )
 
+// setPlanUnreachable updates plan unreachable flag, warning once when the target time can't be met
+func (lp *Loadpoint) setPlanUnreachable(unreachable bool) {
+	if unreachable && !lp.planUnreachable {
+		lp.log.WARN.Printf("target time %v unreachable, charging immediately", lp.targetTime.Round(time.Second).Local())
+	}
+	lp.planUnreachable = unreachable
+	lp.publish(planUnreachable, lp.planUnreachable)
+}
+
 // setPlanActive updates plan active flag
 func (lp *Loadpoint) setPlanActive(active bool) {
 	if !active {
@@ -38,9 +49,81 @@ func (lp *Loadpoint) planRequiredDuration(maxPower float64) time.Duration {
 		targetSoc = 100
 	}
 
+	targetSoc = lp.rangeBufferTargetSoc(lp.GetVehicle(), targetSoc)
+
+	// vehicle's charge curve may limit power below charger maximum
+	if power, ok := lp.vehicleChargePower(); ok && power < maxPower {
+		maxPower = power
+	}
+
 	return lp.socEstimator.RemainingChargeDuration(targetSoc, maxPower)
 }
 
+// rangeBufferSoc converts the range buffer in km into the soc required to cover it.
+// The vehicle's efficiency in km/kWh is derived from its current range, soc and capacity.
+func rangeBufferSoc(buffer float64, rng int64, soc, capacity float64) (float64, bool) {
+	if buffer <= 0 || rng <= 0 || soc <= 0 || capacity <= 0 {
+		return 0, false
+	}
+
+	efficiency := float64(rng) / (capacity * soc / 100)
+
+	return 100 * buffer / efficiency / capacity, true
+}
+
+// rangeBufferTargetSoc adds the soc required for the vehicle's range buffer to the target soc
+func (lp *Loadpoint) rangeBufferTargetSoc(vehicle api.Vehicle, targetSoc int) int {
+	vb, ok := vehicle.(api.VehicleRangeBuffer)
+	if !ok || targetSoc >= 100 {
+		return targetSoc
+	}
+
+	delta, ok := rangeBufferSoc(vb.RangeBuffer(), lp.vehicleRange, lp.vehicleSoc, vehicle.Capacity())
+	if !ok {
+		return targetSoc
+	}
+
+	res := targetSoc + int(math.Ceil(delta))
+	if res > 100 {
+		res = 100
+	}
+
+	return res
+}
+
+// vehicleChargePower estimates the vehicle's effective charge power in W from its finish time.
+// The finish time reflects the vehicle's charge curve and is only available while charging.
+func (lp *Loadpoint) vehicleChargePower() (float64, bool) {
+	vt, ok := lp.GetVehicle().(api.VehicleFinishTimer)
+	if !ok || lp.socEstimator == nil || !lp.charging() {
+		return 0, false
+	}
+
+	finish, err := vt.FinishTime()
+	if err != nil {
+		if !errors.Is(err, api.ErrNotAvailable) {
+			lp.log.ERROR.Printf("vehicle finish time: %v", err)
+		}
+		return 0, false
+	}
+
+	// finish time refers to the vehicle's soc limit if available, otherwise to 100%
+	limit := 100
+	if vs, ok := vt.(api.SocLimiter); ok {
+		if f, err := vs.TargetSoc(); err == nil && f > 0 && f < 100 {
+			limit = int(f)
+		}
+	}
+
+	energy := lp.socEstimator.RemainingChargeEnergy(limit)
+	remaining := lp.clock.Until(finish)
+	if energy <= 0 || remaining <= 0 {
+		return 0, false
+	}
+
+	return energy * 1e3 / remaining.Hours(), true
+}
+
 // GetPlan creates a charging plan
 //
 // Results:
@@ -81,9 +164,13 @@ func (lp *Loadpoint) plannerActive() (active bool) {
 
 	// nothing to do
 	if requiredDuration == 0 {
+		lp.setPlanUnreachable(false)
 		return false
 	}
 
+	// plan starts immediately and continues until target time
+	lp.setPlanUnreachable(requiredDuration > lp.clock.Until(lp.GetTargetTime()))
+
 	planStart := planner.Start(plan)
 	lp.publish(planProjectedStart, planStart)
 
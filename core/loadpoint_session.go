@@ -73,6 +73,7 @@ func (lp *Loadpoint) stopSession() {
 	s.PricePerKWh = lp.sessionEnergy.PricePerKWh()
 	s.Co2PerKWh = lp.sessionEnergy.Co2PerKWh()
 	s.ChargedEnergy = lp.sessionEnergy.TotalWh() / 1e3
+	s.Efficiency = lp.chargeEfficiency.Percentage()
 
 	lp.db.Persist(s)
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/evcc-io/evcc/util"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const (
@@ -653,6 +654,40 @@ func TestTargetSoc(t *testing.T) {
 	}
 }
 
+func TestTargetSocHysteresis(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	lp := &Loadpoint{
+		vehicle: mock.NewMockVehicle(ctrl),
+		Soc: SocConfig{
+			target: 80,
+		},
+	}
+
+	// rising soc stops at target, resumes below hysteresis
+	for _, tc := range []struct {
+		soc float64
+		res bool
+	}{
+		{78, false},
+		{79.9, false},
+		{81, true}, // cached reading beyond target
+		{79, true},
+		{78, true},
+		{77.9, false},
+		{79, false},
+		{80, true},
+	} {
+		lp.vehicleSoc = tc.soc
+		assert.Equal(t, tc.res, lp.targetSocReached(), tc.soc)
+	}
+
+	// changing the target resets the hysteresis
+	lp.setTargetSoc(81)
+	lp.vehicleSoc = 80
+	assert.False(t, lp.targetSocReached())
+}
+
 func TestSocPoll(t *testing.T) {
 	clock := clock.NewMock()
 	tRefresh := pollInterval
@@ -784,3 +819,306 @@ func TestMinSoc(t *testing.T) {
 		assert.Equal(t, tc.res, lp.minSocNotReached(), tc)
 	}
 }
+
+func TestDryRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	charger := mock.NewMockCharger(ctrl)
+
+	util.DryRun = true
+	defer func() { util.DryRun = false }()
+
+	lp := NewLoadpoint(util.NewLogger("foo"))
+	lp.charger = charger
+	lp.MinCurrent = minA
+	lp.MaxCurrent = maxA
+	lp.wakeUpTimer = NewTimer()
+
+	// neither current is applied nor charger enabled
+	charger.EXPECT().MaxCurrent(gomock.Any()).Times(0)
+	charger.EXPECT().Enable(gomock.Any()).Times(0)
+
+	assert.NoError(t, lp.setLimit(maxA, true))
+	assert.Equal(t, float64(maxA), lp.chargeCurrent)
+	assert.True(t, lp.enabled)
+	assert.NoError(t, lp.syncCharger())
+}
+
+func TestDryRunPhasesAndVehicle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	util.DryRun = true
+	defer func() { util.DryRun = false }()
+
+	charger := &struct {
+		*mock.MockCharger
+		*mock.MockPhaseSwitcher
+	}{
+		mock.NewMockCharger(ctrl),
+		mock.NewMockPhaseSwitcher(ctrl),
+	}
+	vehicle := &currentVehicle{MockVehicle: mock.NewMockVehicle(ctrl)}
+	vehicle.MockVehicle.EXPECT().Phases().Return(0).AnyTimes()
+	sv := &stopVehicle{MockVehicle: mock.NewMockVehicle(ctrl)}
+
+	lp := NewLoadpoint(util.NewLogger("foo"))
+	lp.charger = charger
+	lp.vehicle = vehicle
+	lp.setPhases(1)
+
+	// phases are not switched
+	charger.MockPhaseSwitcher.EXPECT().Phases1p3p(gomock.Any()).Times(0)
+	assert.NoError(t, lp.scalePhases(3))
+	assert.Equal(t, 3, lp.GetPhases())
+
+	// vehicle current is not applied
+	charger.MockCharger.EXPECT().MaxCurrent(gomock.Any()).Times(0)
+	assert.NoError(t, lp.setMaxCurrent(maxA))
+	assert.Equal(t, 0.0, vehicle.current)
+
+	// vehicle charging is not stopped
+	lp.vehicle = sv
+	lp.stopVehicleCharge()
+	assert.Equal(t, 0, sv.stopped)
+}
+
+type currentVehicle struct {
+	*mock.MockVehicle
+	current float64
+}
+
+func (v *currentVehicle) MaxCurrent(current float64) error {
+	v.current = current
+	return nil
+}
+
+func TestSetMaxCurrentVehicleFallback(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	charger := mock.NewMockCharger(ctrl)
+	vehicle := &currentVehicle{MockVehicle: mock.NewMockVehicle(ctrl)}
+
+	lp := NewLoadpoint(util.NewLogger("foo"))
+	lp.charger = charger
+	lp.vehicle = vehicle
+
+	// charger current control takes precedence
+	charger.EXPECT().MaxCurrent(int64(maxA)).Return(nil)
+	assert.NoError(t, lp.setMaxCurrent(maxA))
+	assert.Equal(t, 0.0, vehicle.current)
+
+	// vehicle current control for on/off chargers
+	charger.EXPECT().MaxCurrent(int64(minA)).Return(api.ErrNotAvailable)
+	assert.NoError(t, lp.setMaxCurrent(minA))
+	assert.Equal(t, minA, vehicle.current)
+}
+
+type minSocVehicle struct {
+	*mock.MockVehicle
+	min int
+}
+
+func (v *minSocVehicle) MinSoc() int {
+	return v.min
+}
+
+func TestVehicleMinSoc(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	lp := &Loadpoint{
+		vehicle: &minSocVehicle{MockVehicle: mock.NewMockVehicle(ctrl), min: 20},
+	}
+
+	// unknown soc
+	assert.False(t, lp.vehicleMinSocNotReached())
+
+	lp.socUpdated = time.Now()
+
+	// override kicks in below floor and releases once restored
+	for _, tc := range []struct {
+		soc float64
+		res bool
+	}{
+		{25, false},
+		{19.5, true},
+		{15, true},
+		{20, false},
+	} {
+		lp.vehicleSoc = tc.soc
+		assert.Equal(t, tc.res, lp.vehicleMinSocNotReached(), tc.soc)
+	}
+
+	// floor disabled
+	lp.vehicle = &minSocVehicle{MockVehicle: mock.NewMockVehicle(ctrl)}
+	lp.vehicleSoc = 10
+	assert.False(t, lp.vehicleMinSocNotReached())
+}
+
+type finishTimerVehicle struct {
+	*mock.MockVehicle
+	finish time.Time
+}
+
+func (v *finishTimerVehicle) FinishTime() (time.Time, error) {
+	return v.finish, nil
+}
+
+func TestPlanVehicleChargePower(t *testing.T) {
+	clck := clock.NewMock()
+	ctrl := gomock.NewController(t)
+	charger := mock.NewMockCharger(ctrl)
+
+	mv := mock.NewMockVehicle(ctrl)
+	mv.EXPECT().Capacity().Return(50.0).AnyTimes()
+	mv.EXPECT().Soc().Return(50.0, nil).AnyTimes()
+
+	// vehicle takes 10h to full, much slower than the charger allows
+	vehicle := &finishTimerVehicle{MockVehicle: mv, finish: clck.Now().Add(10 * time.Hour)}
+
+	socEstimator := soc.NewEstimator(util.NewLogger("foo"), charger, vehicle, false)
+	_, err := socEstimator.Soc(0)
+	require.NoError(t, err)
+
+	lp := &Loadpoint{
+		log:           util.NewLogger("foo"),
+		clock:         clck,
+		vehicle:       vehicle,
+		socEstimator:  socEstimator,
+		status:        api.StatusB,
+		sessionEnergy: NewEnergyMetrics(),
+	}
+
+	// not charging
+	_, ok := lp.vehicleChargePower()
+	assert.False(t, ok)
+
+	lp.status = api.StatusC
+
+	power, ok := lp.vehicleChargePower()
+	require.True(t, ok)
+	assert.InDelta(t, socEstimator.RemainingChargeEnergy(100)*1e3/10, power, 1e-6)
+
+	// vehicle power limits the required duration
+	assert.Equal(t, socEstimator.RemainingChargeDuration(100, power), lp.planRequiredDuration(11e3))
+	assert.Greater(t, lp.planRequiredDuration(11e3), 5*time.Hour)
+
+	// finish time refers to the vehicle's soc limit
+	lp.vehicle = &limitFinishTimerVehicle{finishTimerVehicle: vehicle, limit: 80}
+
+	power, ok = lp.vehicleChargePower()
+	require.True(t, ok)
+	assert.InDelta(t, socEstimator.RemainingChargeEnergy(80)*1e3/10, power, 1e-6)
+}
+
+type limitFinishTimerVehicle struct {
+	*finishTimerVehicle
+	limit float64
+}
+
+func (v *limitFinishTimerVehicle) TargetSoc() (float64, error) {
+	return v.limit, nil
+}
+
+type bufferVehicle struct {
+	*mock.MockVehicle
+	buffer float64
+}
+
+func (v *bufferVehicle) RangeBuffer() float64 {
+	return v.buffer
+}
+
+func TestRangeBufferSoc(t *testing.T) {
+	tc := []struct {
+		buffer   float64
+		rng      int64
+		soc      float64
+		capacity float64
+		res      float64
+		ok       bool
+	}{
+		{0, 150, 50, 50, 0, false},  // disabled
+		{30, 0, 50, 50, 0, false},   // range unknown
+		{30, 150, 0, 50, 0, false},  // soc unknown
+		{30, 150, 50, 0, 0, false},  // capacity unknown
+		{30, 150, 50, 50, 10, true}, // 6km/kWh
+		{30, 300, 50, 50, 5, true},  // 12km/kWh
+	}
+
+	for _, tc := range tc {
+		t.Logf("%+v", tc)
+
+		res, ok := rangeBufferSoc(tc.buffer, tc.rng, tc.soc, tc.capacity)
+		assert.Equal(t, tc.ok, ok)
+		assert.InDelta(t, tc.res, res, 1e-6)
+	}
+}
+
+func TestRangeBufferTargetSoc(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mv := mock.NewMockVehicle(ctrl)
+	mv.EXPECT().Capacity().Return(50.0).AnyTimes()
+
+	v := &bufferVehicle{MockVehicle: mv, buffer: 30}
+
+	lp := &Loadpoint{
+		vehicle: v,
+		Soc: SocConfig{
+			target: 80,
+		},
+		vehicleSoc:   50,
+		vehicleRange: 150,
+	}
+
+	// 30km at 6km/kWh require 10%
+	assert.Equal(t, 90, lp.rangeBufferTargetSoc(v, 80))
+	assert.Equal(t, 100, lp.rangeBufferTargetSoc(v, 95))
+	assert.Equal(t, 80, lp.rangeBufferTargetSoc(mv, 80))
+
+	// buffer only applies while departure is planned
+	lp.vehicleSoc, lp.vehicleRange = 85, 255
+	assert.True(t, lp.targetSocReached())
+
+	lp.targetSocStopped = false
+	lp.targetTime = time.Now().Add(time.Hour)
+	assert.False(t, lp.targetSocReached())
+
+	lp.vehicleSoc, lp.vehicleRange = 90, 270
+	assert.True(t, lp.targetSocReached())
+}
+
+type stopVehicle struct {
+	*mock.MockVehicle
+	stopped int
+}
+
+func (v *stopVehicle) StartCharge() error {
+	return nil
+}
+
+func (v *stopVehicle) StopCharge() error {
+	v.stopped++
+	return nil
+}
+
+func TestStopVehicleChargeIfCharging(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	v := &stopVehicle{MockVehicle: mock.NewMockVehicle(ctrl)}
+
+	lp := NewLoadpoint(util.NewLogger("foo"))
+	lp.vehicle = v
+	lp.status = api.StatusC
+
+	// charger has just been disabled, status is not yet updated
+	lp.stopVehicleChargeIfCharging()
+	assert.Equal(t, 0, v.stopped)
+
+	// charging continues in the next cycle
+	lp.stopVehicleChargeIfCharging()
+	assert.Equal(t, 1, v.stopped)
+
+	// charging stopped
+	lp.status = api.StatusB
+	lp.stopVehicleChargeIfCharging()
+	assert.Equal(t, 1, v.stopped)
+}
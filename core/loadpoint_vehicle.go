@@ -10,12 +10,15 @@ import (
 	"github.com/evcc-io/evcc/core/db"
 	"github.com/evcc-io/evcc/core/soc"
 	"github.com/evcc-io/evcc/provider"
+	"github.com/evcc-io/evcc/server/db/settings"
 	"golang.org/x/exp/slices"
 )
 
 const (
 	vehicleDetectInterval = 1 * time.Minute
 	vehicleDetectDuration = 10 * time.Minute
+
+	vehicleCurrentTolerance = 1.0 // A
 )
 
 // coordinatedVehicles is the slice of vehicles from the coordinator
@@ -36,6 +39,8 @@ func (lp *Loadpoint) setVehicleIdentifier(id string) {
 
 // identifyVehicle reads vehicle identification from charger
 func (lp *Loadpoint) identifyVehicle() {
+	lp.identifyVehicleByVIN()
+
 	identifier, ok := lp.charger.(api.Identifier)
 	if !ok {
 		return
@@ -64,19 +69,71 @@ func (lp *Loadpoint) identifyVehicle() {
 	}
 }
 
+// identifyVehicleByVIN reads the vehicle identification number from the charger, e.g. via ISO 15118,
+// and activates the matching vehicle whenever the reported VIN changes
+func (lp *Loadpoint) identifyVehicleByVIN() {
+	identifier, ok := lp.charger.(api.VINIdentifier)
+	if !ok {
+		return
+	}
+
+	vin, err := identifier.VIN()
+	if err != nil {
+		if !errors.Is(err, api.ErrNotAvailable) {
+			lp.log.ERROR.Println("charger vehicle vin:", err)
+		}
+		return
+	}
+
+	if lp.vehicleVIN == vin {
+		return
+	}
+
+	// vehicle found or removed
+	lp.vehicleVIN = vin
+
+	if vin != "" {
+		lp.log.DEBUG.Println("charger vehicle vin:", vin)
+
+		if vehicle := lp.selectVehicleByVIN(vin); vehicle != nil {
+			lp.stopVehicleDetection()
+			lp.setActiveVehicle(vehicle)
+		}
+	}
+}
+
+// selectVehicleByVIN selects the vehicle reporting the given VIN, falling back to matching vehicle identifiers
+func (lp *Loadpoint) selectVehicleByVIN(vin string) api.Vehicle {
+	for _, vehicle := range lp.coordinatedVehicles() {
+		if identifier, ok := vehicle.(api.VINIdentifier); ok {
+			if res, err := identifier.VIN(); err == nil && strings.EqualFold(res, vin) {
+				return vehicle
+			}
+		}
+	}
+
+	return lp.selectVehicleByID(vin)
+}
+
 // selectVehicleByID selects the vehicle with the given ID
 func (lp *Loadpoint) selectVehicleByID(id string) api.Vehicle {
 	vehicles := lp.coordinatedVehicles()
 
 	// find exact match
+	var res []api.Vehicle
 	for _, vehicle := range vehicles {
 		for _, vid := range vehicle.Identifiers() {
 			if strings.EqualFold(id, vid) {
-				return vehicle
+				res = append(res, vehicle)
+				break
 			}
 		}
 	}
 
+	if len(res) > 0 {
+		return lp.preferConnectedVehicle(res)
+	}
+
 	// find placeholder match
 	for _, vehicle := range vehicles {
 		for _, vid := range vehicle.Identifiers() {
@@ -88,17 +145,44 @@ func (lp *Loadpoint) selectVehicleByID(id string) api.Vehicle {
 			}
 
 			if re.MatchString(id) {
-				return vehicle
+				res = append(res, vehicle)
+				break
 			}
 		}
 	}
 
-	return nil
+	return lp.preferConnectedVehicle(res)
+}
+
+// preferConnectedVehicle returns the first vehicle reporting connected status
+// if multiple vehicles match, or the first vehicle otherwise
+func (lp *Loadpoint) preferConnectedVehicle(vehicles []api.Vehicle) api.Vehicle {
+	if len(vehicles) == 0 {
+		return nil
+	}
+
+	if len(vehicles) > 1 {
+		for _, vehicle := range vehicles {
+			if vs, ok := vehicle.(api.ChargeState); ok {
+				if status, err := vs.Status(); err == nil && (status == api.StatusB || status == api.StatusC) {
+					return vehicle
+				}
+			}
+		}
+	}
+
+	return vehicles[0]
 }
 
 // setActiveVehicle assigns currently active vehicle, configures soc estimator
 // and adds an odometer task
 func (lp *Loadpoint) setActiveVehicle(vehicle api.Vehicle) {
+	// vehicles disabled at runtime are ignored
+	if vehicle != nil && !lp.coordinator.Enabled(vehicle) {
+		lp.log.DEBUG.Printf("vehicle disabled: %s", vehicle.Title())
+		vehicle = nil
+	}
+
 	lp.vehicleMux.Lock()
 	if lp.vehicle == vehicle {
 		lp.vehicleMux.Unlock()
@@ -117,6 +201,7 @@ func (lp *Loadpoint) setActiveVehicle(vehicle api.Vehicle) {
 	}
 
 	lp.vehicle = vehicle
+	lp.vehicleStatus = api.StatusNone
 	lp.vehicleMux.Unlock()
 
 	lp.log.INFO.Printf("vehicle updated: %s -> %s", from, to)
@@ -134,6 +219,9 @@ func (lp *Loadpoint) setActiveVehicle(vehicle api.Vehicle) {
 	// unlock api
 	lp.Unlock()
 
+	// lifetime energy is specific to the vehicle
+	lp.resetChargeEfficiency()
+
 	if vehicle != nil {
 		lp.socUpdated = time.Time{}
 
@@ -150,9 +238,12 @@ func (lp *Loadpoint) setActiveVehicle(vehicle api.Vehicle) {
 		lp.publish(vehicleCapacity, vehicle.Capacity())
 
 		lp.applyAction(vehicle.OnIdentified())
+		lp.restoreVehicleSettings(vehicle)
 		lp.addTask(lp.vehicleOdometer)
+		lp.addTask(lp.vehicleTirePressure)
 
 		lp.progress.Reset()
+		lp.completion.Reset()
 	} else {
 		lp.socEstimator = nil
 
@@ -161,6 +252,8 @@ func (lp *Loadpoint) setActiveVehicle(vehicle api.Vehicle) {
 		lp.publish(vehicleIcon, "")
 		lp.publish(vehicleCapacity, int64(0))
 		lp.publish(vehicleOdometer, 0.0)
+		lp.publish(vehicleTirePressure, []float64{})
+		lp.publish(vehicleSocEstimated, false)
 	}
 
 	// re-publish vehicle settings
@@ -177,6 +270,54 @@ func (lp *Loadpoint) setActiveVehicle(vehicle api.Vehicle) {
 	})
 }
 
+// vehicleSettingsKey returns the settings key of the vehicle's setting
+func vehicleSettingsKey(vehicle api.Vehicle, key string) string {
+	return "vehicle." + api.VehicleName(vehicle) + "." + key
+}
+
+// saveVehicleSetting stores the soc setting for the active vehicle
+func (lp *Loadpoint) saveVehicleSetting(key string, soc int) {
+	if vehicle := lp.GetVehicle(); vehicle != nil {
+		settings.SetInt(vehicleSettingsKey(vehicle, key), int64(soc))
+	}
+}
+
+// migrateVehicleSettings moves single-value soc settings of the loadpoint to the vehicle.
+// Settings already stored for the vehicle take precedence.
+func (lp *Loadpoint) migrateVehicleSettings(vehicle api.Vehicle) {
+	if lp.settings == "" {
+		return
+	}
+
+	for _, key := range []string{minSoc, targetSoc} {
+		v, err := settings.Int(lp.settings + key)
+		if err != nil {
+			continue
+		}
+
+		if _, err := settings.Int(vehicleSettingsKey(vehicle, key)); errors.Is(err, settings.ErrNotFound) {
+			settings.SetInt(vehicleSettingsKey(vehicle, key), v)
+		}
+
+		if err := settings.Delete(lp.settings + key); err != nil {
+			lp.log.ERROR.Printf("migrate %s: %v", key, err)
+		}
+	}
+}
+
+// restoreVehicleSettings applies the soc settings stored for the vehicle. Stored settings
+// have been chosen by the user and take precedence over the vehicle's onIdentify defaults.
+func (lp *Loadpoint) restoreVehicleSettings(vehicle api.Vehicle) {
+	lp.migrateVehicleSettings(vehicle)
+
+	if v, err := settings.Int(vehicleSettingsKey(vehicle, minSoc)); err == nil {
+		lp.applyMinSoc(int(v))
+	}
+	if v, err := settings.Int(vehicleSettingsKey(vehicle, targetSoc)); err == nil {
+		lp.applyTargetSoc(int(v))
+	}
+}
+
 func (lp *Loadpoint) wakeUpVehicle() {
 	// charger
 	if c, ok := lp.charger.(api.Resurrector); ok {
@@ -187,7 +328,7 @@ func (lp *Loadpoint) wakeUpVehicle() {
 
 	// vehicle
 	if vs, ok := lp.GetVehicle().(api.Resurrector); ok {
-		if err := vs.WakeUp(); err != nil {
+		if err := vs.WakeUp(); err != nil && !errors.Is(err, api.ErrNotAvailable) {
 			lp.log.ERROR.Printf("wake-up vehicle: %v", err)
 		}
 	}
@@ -196,6 +337,7 @@ func (lp *Loadpoint) wakeUpVehicle() {
 // unpublishVehicle resets published vehicle data
 func (lp *Loadpoint) unpublishVehicle() {
 	lp.vehicleSoc = 0
+	lp.vehicleRange = 0
 
 	lp.publish(vehicleSoc, 0.0)
 	lp.publish(vehicleRange, int64(0))
@@ -307,6 +449,10 @@ func (lp *Loadpoint) vehicleOdometer() {
 			lp.log.DEBUG.Printf("vehicle odometer: %.0fkm", odo)
 			lp.publish(vehicleOdometer, odo)
 
+			if lp.vehicleServiceDue(lp.GetVehicle(), odo) {
+				lp.pushEvent(evVehicleService)
+			}
+
 			// update session once odometer is read
 			lp.updateSession(func(session *db.Session) {
 				session.Odometer = &odo
@@ -317,6 +463,197 @@ func (lp *Loadpoint) vehicleOdometer() {
 	}
 }
 
+// vehicleServiceDue checks if the odometer has crossed the vehicle's next service threshold.
+// The baseline and the next threshold are kept in the settings store. The first reading sets
+// the baseline. Once due, the next threshold is advanced by the service interval.
+func (lp *Loadpoint) vehicleServiceDue(vehicle api.Vehicle, odo float64) bool {
+	vs, ok := vehicle.(api.VehicleServiceInterval)
+	if !ok || vs.ServiceInterval() <= 0 {
+		return false
+	}
+
+	interval := vs.ServiceInterval()
+	baselineKey := vehicleSettingsKey(vehicle, "serviceBaseline")
+	nextKey := vehicleSettingsKey(vehicle, "serviceNext")
+
+	baseline, err := settings.Float(baselineKey)
+	if err != nil || baseline > odo {
+		// first reading or odometer replaced
+		baseline = odo
+	}
+
+	// changed interval applies from the baseline
+	next := baseline + interval
+
+	due := odo >= next
+	if due {
+		lp.log.INFO.Printf("vehicle service due: %.0fkm >= %.0fkm", odo, next)
+
+		for next <= odo {
+			baseline = next
+			next += interval
+		}
+	}
+
+	settings.SetFloat(baselineKey, baseline)
+	settings.SetFloat(nextKey, next)
+	lp.publish(vehicleNextService, next)
+
+	return due
+}
+
+// vehicleTirePressure updates tire pressures
+func (lp *Loadpoint) vehicleTirePressure() {
+	if vs, ok := lp.GetVehicle().(api.VehicleTirePressure); ok {
+		if fl, fr, rl, rr, err := vs.TirePressure(); err == nil {
+			lp.log.DEBUG.Printf("vehicle tire pressure: %.1f/%.1f/%.1f/%.1fbar", fl, fr, rl, rr)
+			lp.publish(vehicleTirePressure, []float64{fl, fr, rl, rr})
+		} else if !errors.Is(err, api.ErrNotAvailable) {
+			lp.log.ERROR.Printf("vehicle tire pressure: %v", err)
+		}
+	}
+}
+
+// VehicleStatusChanged is published on the loadpoint's event bus when the active vehicle's charge status changes
+type VehicleStatusChanged struct {
+	Vehicle  string
+	From, To api.ChargeStatus
+}
+
+// PluggedIn returns true if the vehicle reports having been plugged in
+func (ev VehicleStatusChanged) PluggedIn() bool {
+	return ev.From == api.StatusA && ev.To == api.StatusB
+}
+
+// vehicleMinSocNotReached checks if the vehicle's soc floor is configured and the known soc is below
+func (lp *Loadpoint) vehicleMinSocNotReached() bool {
+	v, ok := lp.GetVehicle().(api.VehicleMinSoc)
+	if !ok || v.MinSoc() <= 0 || lp.socUpdated.IsZero() {
+		return false
+	}
+
+	return lp.vehicleSoc < float64(v.MinSoc())
+}
+
+// priceGateOpen checks if the price gate allows charging from grid and publishes the reasoning
+func (lp *Loadpoint) priceGateOpen() bool {
+	if lp.priceGate == nil {
+		return false
+	}
+
+	d := lp.priceGate.Decide(lp.GetVehicle())
+	lp.log.DEBUG.Printf("price gate: charge %t (%s)", d.Charge, d.Reason)
+	lp.publish(priceGate, d)
+
+	return d.Charge
+}
+
+// vehicleAvailable checks if the vehicle is available for charging at the current time
+func (lp *Loadpoint) vehicleAvailable() bool {
+	if v, ok := lp.GetVehicle().(api.VehicleAvailability); ok {
+		return v.Available(lp.clock.Now())
+	}
+
+	return true
+}
+
+// updateVehicleStatus reads the active vehicle's charge status and publishes status changes
+func (lp *Loadpoint) updateVehicleStatus() {
+	vehicle := lp.GetVehicle()
+
+	vs, ok := vehicle.(api.ChargeState)
+	if !ok {
+		return
+	}
+
+	status, err := vs.Status()
+	if err != nil {
+		if !errors.Is(err, api.ErrNotAvailable) {
+			lp.log.ERROR.Printf("vehicle status: %v", err)
+		}
+		return
+	}
+
+	if status == lp.vehicleStatus {
+		return
+	}
+
+	ev := VehicleStatusChanged{
+		Vehicle: vehicle.Title(),
+		From:    lp.vehicleStatus,
+		To:      status,
+	}
+
+	lp.vehicleStatus = status
+	lp.bus.Publish(evVehicleStatus, ev)
+
+	if ev.PluggedIn() {
+		lp.bus.Publish(evVehiclePlugIn, ev)
+	}
+}
+
+// pushVehicleSchedule transfers the charge plan to the vehicle's native schedule.
+// The vehicle continues charging according to plan if evcc is offline.
+func (lp *Loadpoint) pushVehicleSchedule(finishAt time.Time, soc int) {
+	vs, ok := lp.GetVehicle().(api.VehicleChargeScheduler)
+	if !ok {
+		return
+	}
+
+	go func() {
+		if err := vs.SetChargeSchedule(api.ChargeSchedule{Departure: finishAt, Soc: soc}); err != nil && !errors.Is(err, api.ErrNotAvailable) {
+			lp.log.WARN.Printf("vehicle schedule: %v", err)
+		}
+	}()
+}
+
+// checkVehicleCurrent compares the vehicle's charge current with the charger's current limit
+// to detect the vehicle charging below the offered current
+func (lp *Loadpoint) checkVehicleCurrent() {
+	vs, ok := lp.GetVehicle().(api.VehicleCurrent)
+	if !ok {
+		return
+	}
+
+	current, err := vs.ChargeCurrent()
+	if err != nil {
+		if !errors.Is(err, api.ErrNotAvailable) {
+			lp.log.ERROR.Printf("vehicle charge current: %v", err)
+		}
+		return
+	}
+
+	lp.log.DEBUG.Printf("vehicle charge current: %.1fA", current)
+	lp.publish(vehicleChargeCurrent, current)
+
+	if current < lp.chargeCurrent-vehicleCurrentTolerance {
+		lp.log.INFO.Printf("vehicle charging below current limit: %.1fA < %.1fA", current, lp.chargeCurrent)
+	}
+}
+
+// vehiclePosition returns the vehicle position if available.
+// Vehicles reporting null island (0,0) are treated as position unknown.
+func (lp *Loadpoint) vehiclePosition() (float64, float64, bool) {
+	vs, ok := lp.GetVehicle().(api.VehiclePosition)
+	if !ok {
+		return 0, 0, false
+	}
+
+	lat, lon, err := vs.Position()
+	if err != nil {
+		if !errors.Is(err, api.ErrNotAvailable) {
+			lp.log.ERROR.Printf("vehicle position: %v", err)
+		}
+		return 0, 0, false
+	}
+
+	if lat == 0 && lon == 0 {
+		return 0, 0, false
+	}
+
+	return lat, lon, true
+}
+
 // vehicleClimatePollAllowed determines if polling depending on mode and connection status
 func (lp *Loadpoint) vehicleClimatePollAllowed() bool {
 	switch {
@@ -357,6 +694,48 @@ func (lp *Loadpoint) vehicleSocPollAllowed() bool {
 	return false
 }
 
+// selectSoc applies the configured soc source precedence to the estimator soc and returns if the result is estimated
+func (lp *Loadpoint) selectSoc(f float64) (float64, bool) {
+	estimated := lp.socEstimator.Estimated()
+
+	switch lp.Soc.Source {
+	case socSourceVehicle:
+		if soc, ok := lp.socEstimator.LastSoc(); ok {
+			return soc, false
+		}
+
+	case socSourceEstimate:
+		if soc, ok := lp.socEstimator.EnergySoc(lp.getChargedEnergy()); ok {
+			return soc, true
+		}
+
+	case socSourceAuto:
+		if estimated || lp.vehicleSocStale() {
+			if soc, ok := lp.socEstimator.EnergySoc(lp.getChargedEnergy()); ok {
+				return soc, true
+			}
+		}
+
+		if soc, ok := lp.socEstimator.LastSoc(); ok {
+			return soc, false
+		}
+	}
+
+	return f, estimated
+}
+
+// vehicleSocStale checks if the vehicle reports its data being older than the soc max age.
+// Data of unknown age is considered stale.
+func (lp *Loadpoint) vehicleSocStale() bool {
+	vu, ok := lp.GetVehicle().(api.VehicleUpdated)
+	if !ok || lp.Soc.MaxAge <= 0 {
+		return false
+	}
+
+	updated, err := vu.Updated()
+	return err != nil || updated.IsZero() || lp.clock.Since(updated) > lp.Soc.MaxAge
+}
+
 // vehicleClimateActive checks if vehicle has active climate request
 func (lp *Loadpoint) vehicleClimateActive() bool {
 	if cl, ok := lp.GetVehicle().(api.VehicleClimater); ok && lp.vehicleClimatePollAllowed() {
@@ -377,3 +756,39 @@ func (lp *Loadpoint) vehicleClimateActive() bool {
 
 	return false
 }
+
+// updateChargeEfficiency compares the vehicle's lifetime energy to the session's charged energy
+func (lp *Loadpoint) updateChargeEfficiency() {
+	ve, ok := lp.GetVehicle().(api.VehicleEnergy)
+	if !ok {
+		return
+	}
+
+	// charged energy must be metered, without meter it is taken from the vehicle's own counter
+	if _, ok := lp.chargeMeter.(api.MeterEnergy); !ok {
+		lp.publish(sessionEfficiency, nil)
+		return
+	}
+
+	energy, err := ve.LifetimeEnergy()
+	if err != nil {
+		if !errors.Is(err, api.ErrNotAvailable) {
+			lp.log.ERROR.Printf("vehicle lifetime energy: %v", err)
+		}
+		return
+	}
+
+	lp.chargeEfficiency.Update(energy, lp.getChargedEnergy()/1e3)
+
+	if f := lp.chargeEfficiency.Percentage(); f != nil {
+		lp.log.DEBUG.Printf("charge efficiency: %.1f%%", *f)
+	}
+
+	lp.publish(sessionEfficiency, lp.chargeEfficiency.Percentage())
+}
+
+// resetChargeEfficiency clears the charge efficiency on vehicle or session change
+func (lp *Loadpoint) resetChargeEfficiency() {
+	lp.chargeEfficiency.Reset()
+	lp.publish(sessionEfficiency, nil)
+}
@@ -11,9 +11,11 @@ import (
 	"github.com/evcc-io/evcc/core/coordinator"
 	"github.com/evcc-io/evcc/core/soc"
 	"github.com/evcc-io/evcc/mock"
+	"github.com/evcc-io/evcc/server/db/settings"
 	"github.com/evcc-io/evcc/util"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPublishSocAndRange(t *testing.T) {
@@ -100,6 +102,7 @@ func TestVehicleDetectByID(t *testing.T) {
 		}},
 		{"1/1/2->1", "1", "1", "2", v1, func(tc testcase) {
 			v1.EXPECT().Identifiers().Return([]string{tc.i1})
+			v2.EXPECT().Identifiers().Return([]string{tc.i2})
 		}},
 		{"2/1/2->2", "2", "1", "2", v2, func(tc testcase) {
 			v1.EXPECT().Identifiers().Return([]string{tc.i1})
@@ -109,7 +112,7 @@ func TestVehicleDetectByID(t *testing.T) {
 			v1.EXPECT().Identifiers().Return([]string{tc.i1})
 			v2.EXPECT().Identifiers().Return([]string{tc.i2})
 			v1.EXPECT().Identifiers().Return([]string{tc.i1})
-			// v2.EXPECT().Identifiers().Return([]string{tc.i2})
+			v2.EXPECT().Identifiers().Return([]string{tc.i2})
 		}},
 		{"22/1*/2*->2", "22", "1*", "2*", v2, func(tc testcase) {
 			v1.EXPECT().Identifiers().Return([]string{tc.i1})
@@ -144,6 +147,48 @@ func TestVehicleDetectByID(t *testing.T) {
 	}
 }
 
+func TestVehicleDetectByIDPrefersConnected(t *testing.T) {
+	type vehicle struct {
+		*mock.MockVehicle
+		*mock.MockChargeState
+	}
+
+	tc := []struct {
+		v1, v2 api.ChargeStatus
+		res    int
+	}{
+		{api.StatusA, api.StatusA, 1},
+		{api.StatusB, api.StatusA, 1},
+		{api.StatusA, api.StatusB, 2},
+		{api.StatusA, api.StatusC, 2},
+		{api.StatusC, api.StatusB, 1},
+	}
+
+	for _, tc := range tc {
+		t.Logf("%+v", tc)
+
+		ctrl := gomock.NewController(t)
+
+		v1 := &vehicle{mock.NewMockVehicle(ctrl), mock.NewMockChargeState(ctrl)}
+		v2 := &vehicle{mock.NewMockVehicle(ctrl), mock.NewMockChargeState(ctrl)}
+
+		v1.MockVehicle.EXPECT().Identifiers().Return([]string{"id"}).AnyTimes()
+		v2.MockVehicle.EXPECT().Identifiers().Return([]string{"id"}).AnyTimes()
+		v1.MockChargeState.EXPECT().Status().Return(tc.v1, nil).AnyTimes()
+		v2.MockChargeState.EXPECT().Status().Return(tc.v2, nil).AnyTimes()
+
+		lp := &Loadpoint{
+			log: util.NewLogger("foo"),
+		}
+
+		lp.coordinator = coordinator.NewAdapter(lp, coordinator.New(util.NewLogger("foo"), []api.Vehicle{v1, v2}))
+
+		if exp, res := []api.Vehicle{v1, v2}[tc.res-1], lp.selectVehicleByID("id"); exp != res {
+			t.Errorf("expected %v, got %v", exp, res)
+		}
+	}
+}
+
 func TestDefaultVehicle(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
@@ -372,8 +417,8 @@ func TestReconnectVehicle(t *testing.T) {
 
 			// sync charger
 			charger.EXPECT().Enabled().Return(true, nil)
-			// vehicle not updated yet
-			vehicle.MockChargeState.EXPECT().Status().Return(api.StatusB, nil)
+			// vehicle not updated yet, status is read again once vehicle is detected
+			vehicle.MockChargeState.EXPECT().Status().Return(api.StatusB, nil).MinTimes(1)
 
 			lp.Update(0, false, false, false, 0, nil, nil)
 			ctrl.Finish()
@@ -383,3 +428,543 @@ func TestReconnectVehicle(t *testing.T) {
 		})
 	}
 }
+
+func TestVehicleStatusChanged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	type vehicle struct {
+		*mock.MockVehicle
+		*mock.MockChargeState
+	}
+
+	v := &vehicle{mock.NewMockVehicle(ctrl), mock.NewMockChargeState(ctrl)}
+	v.MockVehicle.EXPECT().Title().Return("target").AnyTimes()
+
+	lp := &Loadpoint{
+		log:     util.NewLogger("foo"),
+		bus:     evbus.New(),
+		vehicle: v,
+	}
+
+	var events []VehicleStatusChanged
+	_ = lp.bus.Subscribe(evVehicleStatus, func(ev VehicleStatusChanged) {
+		events = append(events, ev)
+	})
+
+	for _, status := range []api.ChargeStatus{api.StatusA, api.StatusB, api.StatusB, api.StatusC, api.StatusB} {
+		v.MockChargeState.EXPECT().Status().Return(status, nil)
+		lp.updateVehicleStatus()
+	}
+
+	assert.Equal(t, []VehicleStatusChanged{
+		{"target", api.StatusNone, api.StatusA},
+		{"target", api.StatusA, api.StatusB},
+		{"target", api.StatusB, api.StatusC},
+		{"target", api.StatusC, api.StatusB},
+	}, events)
+}
+
+func TestVehiclePluggedIn(t *testing.T) {
+	tc := []struct {
+		from, to api.ChargeStatus
+		res      bool
+	}{
+		{api.StatusA, api.StatusB, true},
+		{api.StatusNone, api.StatusB, false}, // initial status
+		{api.StatusA, api.StatusC, false},
+		{api.StatusC, api.StatusB, false},
+		{api.StatusB, api.StatusA, false},
+	}
+
+	for _, tc := range tc {
+		ev := VehicleStatusChanged{From: tc.from, To: tc.to}
+		assert.Equal(t, tc.res, ev.PluggedIn(), "%s -> %s", tc.from, tc.to)
+	}
+}
+
+func TestVehiclePlugInEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	type vehicle struct {
+		*mock.MockVehicle
+		*mock.MockChargeState
+	}
+
+	v := &vehicle{mock.NewMockVehicle(ctrl), mock.NewMockChargeState(ctrl)}
+	v.MockVehicle.EXPECT().Title().Return("target").AnyTimes()
+
+	lp := &Loadpoint{
+		log:     util.NewLogger("foo"),
+		bus:     evbus.New(),
+		vehicle: v,
+	}
+
+	var events []VehicleStatusChanged
+	_ = lp.bus.Subscribe(evVehiclePlugIn, func(ev VehicleStatusChanged) {
+		events = append(events, ev)
+	})
+
+	for _, status := range []api.ChargeStatus{api.StatusB, api.StatusA, api.StatusB, api.StatusC, api.StatusB} {
+		v.MockChargeState.EXPECT().Status().Return(status, nil)
+		lp.updateVehicleStatus()
+	}
+
+	assert.Equal(t, []VehicleStatusChanged{
+		{"target", api.StatusA, api.StatusB},
+	}, events)
+}
+
+func TestDisabledVehicle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	vehicle := mock.NewMockVehicle(ctrl)
+	vehicle.EXPECT().Title().Return("vehicle").AnyTimes()
+
+	lp := &Loadpoint{log: util.NewLogger("foo")}
+
+	c := coordinator.New(util.NewLogger("foo"), []api.Vehicle{vehicle})
+	lp.coordinator = coordinator.NewAdapter(lp, c)
+
+	// disabled vehicle is neither selectable nor assigned
+	c.SetEnabled(vehicle, false)
+	assert.Empty(t, lp.coordinatedVehicles())
+
+	lp.setActiveVehicle(vehicle)
+	assert.Nil(t, lp.vehicle)
+}
+
+// updatedVehicle is a vehicle reporting the time of its last data update
+type updatedVehicle struct {
+	*mock.MockVehicle
+	updated time.Time
+}
+
+func (v *updatedVehicle) Updated() (time.Time, error) {
+	if v.updated.IsZero() {
+		return time.Time{}, api.ErrNotAvailable
+	}
+	return v.updated, nil
+}
+
+func TestSelectSocSource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	clck := clock.NewMock()
+
+	charger := mock.NewMockCharger(ctrl)
+
+	// 45 kWh battery capacity is converted to 50 kWh virtual capacity, i.e. 500 Wh per percent
+	v := &updatedVehicle{MockVehicle: mock.NewMockVehicle(ctrl), updated: clck.Now()}
+	v.MockVehicle.EXPECT().Capacity().Return(float64(45)).AnyTimes()
+
+	log := util.NewLogger("foo")
+
+	tc := []struct {
+		source        string
+		age           time.Duration
+		chargedEnergy float64 // kWh charged since the vehicle soc was updated
+		soc           float64
+		estimated     bool
+	}{
+		{socSourceVehicle, time.Hour, 5, 40, false},
+		{socSourceEstimate, 0, 5, 50, true},
+		// fresh vehicle soc takes precedence
+		{socSourceAuto, time.Minute, 5, 40, false},
+		// stale vehicle soc falls back to estimate
+		{socSourceAuto, time.Hour, 5, 50, true},
+	}
+
+	for _, tc := range tc {
+		t.Logf("%+v", tc)
+
+		lp := &Loadpoint{
+			log:           log,
+			clock:         clck,
+			vehicle:       v,
+			socEstimator:  soc.NewEstimator(log, charger, v, false),
+			sessionEnergy: NewEnergyMetrics(),
+		}
+		lp.Soc.Source = tc.source
+		lp.Soc.MaxAge = socMaxAge
+
+		// vehicle soc received before charging
+		v.MockVehicle.EXPECT().Soc().Return(40.0, nil)
+		_, err := lp.socEstimator.Soc(lp.getChargedEnergy())
+		assert.NoError(t, err)
+
+		// outdated soc is still returned while charging
+		clck.Add(tc.age)
+		lp.sessionEnergy.Update(tc.chargedEnergy)
+
+		v.MockVehicle.EXPECT().Soc().Return(40.0, nil)
+		f, err := lp.socEstimator.Soc(lp.getChargedEnergy())
+		assert.NoError(t, err)
+
+		soc, estimated := lp.selectSoc(f)
+		assert.InDelta(t, tc.soc, soc, 1e-6)
+		assert.Equal(t, tc.estimated, estimated)
+
+		v.updated = clck.Now()
+	}
+}
+
+func TestSelectSocSourceRecovery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	clck := clock.NewMock()
+
+	charger := mock.NewMockCharger(ctrl)
+
+	v := &updatedVehicle{MockVehicle: mock.NewMockVehicle(ctrl), updated: clck.Now()}
+	v.MockVehicle.EXPECT().Capacity().Return(float64(45)).AnyTimes()
+
+	log := util.NewLogger("foo")
+	lp := &Loadpoint{
+		log:           log,
+		clock:         clck,
+		vehicle:       v,
+		socEstimator:  soc.NewEstimator(log, charger, v, false),
+		sessionEnergy: NewEnergyMetrics(),
+	}
+	lp.Soc.Source = socSourceAuto
+	lp.Soc.MaxAge = socMaxAge
+
+	update := func(vehicleSoc float64) (float64, bool) {
+		v.MockVehicle.EXPECT().Soc().Return(vehicleSoc, nil)
+		f, err := lp.socEstimator.Soc(lp.getChargedEnergy())
+		assert.NoError(t, err)
+		return lp.selectSoc(f)
+	}
+
+	soc, estimated := update(40)
+	assert.Equal(t, 40.0, soc)
+	assert.False(t, estimated)
+
+	// vehicle stops updating
+	clck.Add(time.Hour)
+	lp.sessionEnergy.Update(2.5)
+
+	soc, estimated = update(40)
+	assert.InDelta(t, 45, soc, 1e-6)
+	assert.True(t, estimated)
+
+	// vehicle recovers
+	v.updated = clck.Now()
+	lp.sessionEnergy.Update(3)
+
+	soc, estimated = update(46)
+	assert.Equal(t, 46.0, soc)
+	assert.False(t, estimated)
+}
+
+// vinVehicle is a vehicle reporting its vin
+type vinVehicle struct {
+	*mock.MockVehicle
+	vin string
+}
+
+func (v *vinVehicle) VIN() (string, error) {
+	return v.vin, nil
+}
+
+// vinCharger is a charger reporting the connected vehicle's vin
+type vinCharger struct {
+	*mock.MockCharger
+	vin string
+}
+
+func (c *vinCharger) VIN() (string, error) {
+	return c.vin, nil
+}
+
+func TestIdentifyVehicleByVIN(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	newVehicle := func(title, vin string) *vinVehicle {
+		v := &vinVehicle{MockVehicle: mock.NewMockVehicle(ctrl), vin: vin}
+		v.MockVehicle.EXPECT().Title().Return(title).AnyTimes()
+		v.MockVehicle.EXPECT().Icon().Return("").AnyTimes()
+		v.MockVehicle.EXPECT().Capacity().AnyTimes()
+		v.MockVehicle.EXPECT().Phases().AnyTimes()
+		v.MockVehicle.EXPECT().OnIdentified().AnyTimes()
+		v.MockVehicle.EXPECT().Identifiers().AnyTimes()
+		return v
+	}
+
+	v1 := newVehicle("v1", "WVW111")
+	v2 := newVehicle("v2", "WVW222")
+
+	charger := &vinCharger{MockCharger: mock.NewMockCharger(ctrl)}
+
+	lp := NewLoadpoint(util.NewLogger("foo"))
+	lp.charger = charger
+	lp.coordinator = coordinator.NewAdapter(lp, coordinator.New(util.NewLogger("foo"), []api.Vehicle{v1, v2}))
+
+	// populate channels
+	x, y, z := createChannels(t)
+	attachChannels(lp, x, y, z)
+
+	tc := []struct {
+		vin     string
+		vehicle api.Vehicle
+	}{
+		{"", nil},
+		{"wvw222", v2}, // case insensitive match
+		{"WVW111", v1}, // switch on vin change
+		{"WVW999", v1}, // unknown vin keeps active vehicle
+		{"WVW222", v2},
+	}
+
+	for _, tc := range tc {
+		t.Logf("%+v", tc)
+
+		charger.vin = tc.vin
+		lp.identifyVehicle()
+
+		assert.Equal(t, tc.vin, lp.vehicleVIN)
+		assert.Equal(t, tc.vehicle, lp.GetVehicle())
+	}
+
+	// disconnect forgets the vin
+	lp.evVehicleDisconnectHandler()
+	assert.Empty(t, lp.vehicleVIN)
+}
+
+func TestVehicleSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	newVehicle := func(title string, oi api.ActionConfig) *mock.MockVehicle {
+		v := mock.NewMockVehicle(ctrl)
+		v.EXPECT().Title().Return(title).AnyTimes()
+		v.EXPECT().Icon().Return("").AnyTimes()
+		v.EXPECT().Capacity().AnyTimes()
+		v.EXPECT().Phases().AnyTimes()
+		v.EXPECT().OnIdentified().Return(oi).AnyTimes()
+		return v
+	}
+
+	newLoadpoint := func() *Loadpoint {
+		lp := NewLoadpoint(util.NewLogger("foo"))
+		x, y, z := createChannels(t)
+		attachChannels(lp, x, y, z)
+		return lp
+	}
+
+	target := 90
+	vehicle := newVehicle(t.Name(), api.ActionConfig{TargetSoc: &target})
+
+	// onIdentify defaults are applied but not stored
+	lp := newLoadpoint()
+	lp.setActiveVehicle(vehicle)
+	assert.Equal(t, 90, lp.GetTargetSoc())
+
+	_, err := settings.Int(vehicleSettingsKey(vehicle, targetSoc))
+	assert.ErrorIs(t, err, settings.ErrNotFound)
+
+	// user settings are stored per vehicle
+	lp.SetTargetSoc(80)
+	lp.SetMinSoc(20)
+
+	res, err := settings.Int(vehicleSettingsKey(vehicle, targetSoc))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(80), res)
+
+	// restored after restart, taking precedence over onIdentify
+	lp = newLoadpoint()
+	lp.setActiveVehicle(vehicle)
+	assert.Equal(t, 80, lp.GetTargetSoc())
+	assert.Equal(t, 20, lp.GetMinSoc())
+
+	// other vehicles are not affected
+	lp.setActiveVehicle(newVehicle(t.Name()+"-other", api.ActionConfig{}))
+	assert.Equal(t, 100, lp.GetTargetSoc())
+	assert.Equal(t, 0, lp.GetMinSoc())
+}
+
+// serviceVehicle is a vehicle with service interval
+type serviceVehicle struct {
+	*mock.MockVehicle
+	interval float64
+}
+
+func (v *serviceVehicle) ServiceInterval() float64 {
+	return v.interval
+}
+
+func TestVehicleServiceDue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	v := &serviceVehicle{MockVehicle: mock.NewMockVehicle(ctrl), interval: 1000}
+	v.MockVehicle.EXPECT().Title().Return(t.Name()).AnyTimes()
+
+	lp := NewLoadpoint(util.NewLogger("foo"))
+
+	tc := []struct {
+		odo      float64
+		due      bool
+		baseline float64
+	}{
+		{10500, false, 10500}, // first reading sets baseline
+		{11000, false, 10500},
+		{11500, true, 11500}, // threshold crossed
+		{11600, false, 11500},
+		{14000, true, 13500}, // multiple intervals crossed notify once
+		{14100, false, 13500},
+	}
+
+	for _, tc := range tc {
+		t.Logf("%+v", tc)
+
+		assert.Equal(t, tc.due, lp.vehicleServiceDue(v, tc.odo))
+
+		baseline, err := settings.Float(vehicleSettingsKey(v, "serviceBaseline"))
+		assert.NoError(t, err)
+		assert.Equal(t, tc.baseline, baseline)
+
+		next, err := settings.Float(vehicleSettingsKey(v, "serviceNext"))
+		assert.NoError(t, err)
+		assert.Equal(t, tc.baseline+v.interval, next)
+	}
+
+	// baseline persists across restarts
+	lp = NewLoadpoint(util.NewLogger("foo"))
+	assert.False(t, lp.vehicleServiceDue(v, 14400))
+	assert.True(t, lp.vehicleServiceDue(v, 14500))
+
+	// disabled without interval
+	v.interval = 0
+	assert.False(t, lp.vehicleServiceDue(v, 20000))
+}
+
+func TestVehicleSocStale(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	clck := clock.NewMock()
+
+	v := &updatedVehicle{MockVehicle: mock.NewMockVehicle(ctrl)}
+
+	lp := &Loadpoint{
+		clock:   clck,
+		vehicle: v,
+	}
+	lp.Soc.MaxAge = socMaxAge
+
+	// unknown data age
+	assert.True(t, lp.vehicleSocStale())
+
+	v.updated = clck.Now()
+	assert.False(t, lp.vehicleSocStale())
+
+	clck.Add(time.Hour)
+	assert.True(t, lp.vehicleSocStale())
+
+	// vehicle not reporting its data age
+	lp.vehicle = mock.NewMockVehicle(ctrl)
+	assert.False(t, lp.vehicleSocStale())
+}
+
+type namedVehicle struct {
+	*mock.MockVehicle
+	name string
+}
+
+func (v *namedVehicle) Name() string {
+	return v.name
+}
+
+func TestVehicleSettingsKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	v := mock.NewMockVehicle(ctrl)
+	v.EXPECT().Title().Return("My Car (offline)").AnyTimes()
+
+	// settings are keyed by configured name independent of title
+	assert.Equal(t, "vehicle.car.targetSoc", vehicleSettingsKey(&namedVehicle{v, "car"}, targetSoc))
+
+	// title is used if name is not available
+	assert.Equal(t, "vehicle.My Car (offline).targetSoc", vehicleSettingsKey(v, targetSoc))
+}
+
+type energyVehicle struct {
+	*mock.MockVehicle
+	energy float64
+}
+
+func (v *energyVehicle) LifetimeEnergy() (float64, error) {
+	return v.energy, nil
+}
+
+type energyMeter struct {
+	Null
+}
+
+func (m *energyMeter) TotalEnergy() (float64, error) {
+	return 0, nil
+}
+
+func TestUpdateChargeEfficiency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	v := &energyVehicle{MockVehicle: mock.NewMockVehicle(ctrl), energy: 100}
+
+	for _, tc := range []struct {
+		meter   api.Meter
+		metered bool
+	}{
+		{&Null{}, false},
+		{&energyMeter{}, true},
+	} {
+		lp := &Loadpoint{
+			log:           util.NewLogger("foo"),
+			vehicle:       v,
+			chargeMeter:   tc.meter,
+			sessionEnergy: NewEnergyMetrics(),
+		}
+
+		v.energy = 100
+		lp.updateChargeEfficiency()
+
+		// vehicle stores 9 of 10 kWh charged
+		v.energy = 109
+		lp.sessionEnergy.Update(10)
+		lp.updateChargeEfficiency()
+
+		if !tc.metered {
+			// unmetered charged energy is not compared to vehicle energy
+			assert.Nil(t, lp.chargeEfficiency.Percentage())
+			continue
+		}
+
+		require.NotNil(t, lp.chargeEfficiency.Percentage())
+		assert.InDelta(t, 90, *lp.chargeEfficiency.Percentage(), 1e-6)
+	}
+}
+
+func TestMigrateVehicleSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	v := mock.NewMockVehicle(ctrl)
+	v.EXPECT().Title().Return(t.Name()).AnyTimes()
+
+	lp := NewLoadpoint(util.NewLogger("foo"))
+	lp.settings = "lp9."
+
+	settings.SetInt("lp9."+targetSoc, 70)
+	settings.SetInt("lp9."+minSoc, 10)
+
+	// vehicle setting takes precedence
+	settings.SetInt(vehicleSettingsKey(v, minSoc), 20)
+
+	lp.migrateVehicleSettings(v)
+
+	res, err := settings.Int(vehicleSettingsKey(v, targetSoc))
+	require.NoError(t, err)
+	assert.Equal(t, int64(70), res)
+
+	res, err = settings.Int(vehicleSettingsKey(v, minSoc))
+	require.NoError(t, err)
+	assert.Equal(t, int64(20), res)
+
+	// loadpoint settings are removed
+	for _, key := range []string{targetSoc, minSoc} {
+		_, err := settings.Int("lp9." + key)
+		assert.ErrorIs(t, err, settings.ErrNotFound)
+	}
+}
@@ -0,0 +1,136 @@
+package pricegate
+
+import (
+	"errors"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+)
+
+// Config configures the price gate
+type Config struct {
+	MaxPrice float64 `mapstructure:"maxPrice"` // charge from grid at or below this price, disabled if zero
+	Soc      int     `mapstructure:"soc"`      // guaranteed soc floor charged regardless of price
+}
+
+// Reason explains the gate decision
+type Reason string
+
+const (
+	ReasonPrice      Reason = "price"      // price at or below limit
+	ReasonFloor      Reason = "floor"      // soc below guaranteed floor
+	ReasonSocUnknown Reason = "socUnknown" // soc not available, floor assumed not reached
+	ReasonWait       Reason = "wait"       // price above limit and floor reached, waiting for cheaper window
+	ReasonNoPrice    Reason = "noPrice"    // current price not available
+)
+
+// Decision is the gate decision including its reasoning
+type Decision struct {
+	Charge    bool       `json:"charge"`
+	Reason    Reason     `json:"reason"`
+	Price     *float64   `json:"price,omitempty"`
+	MaxPrice  float64    `json:"maxPrice"`
+	Soc       *float64   `json:"soc,omitempty"`
+	Floor     int        `json:"floor"`
+	NextCheap *time.Time `json:"nextCheap,omitempty"` // start of the next window at or below the limit
+}
+
+// Gate decides if charging from grid is allowed based on price and vehicle soc
+type Gate struct {
+	log    *util.Logger
+	clock  clock.Clock
+	tariff api.Tariff
+	config Config
+}
+
+// New creates a price gate. It returns nil if the gate is disabled or no tariff is available.
+func New(log *util.Logger, tariff api.Tariff, config Config) *Gate {
+	if tariff == nil || config.MaxPrice == 0 {
+		return nil
+	}
+
+	return &Gate{
+		log:    log,
+		clock:  clock.New(),
+		tariff: tariff,
+		config: config,
+	}
+}
+
+// Decide decides if the vehicle may charge from grid at the current price.
+// Above the price limit, charging is only allowed while the vehicle soc is below the guaranteed floor.
+// A vehicle soc that cannot be read is treated as below the floor.
+func (g *Gate) Decide(vehicle api.Vehicle) Decision {
+	res := Decision{
+		MaxPrice: g.config.MaxPrice,
+		Floor:    g.config.Soc,
+	}
+
+	now := g.clock.Now()
+
+	rates, err := g.tariff.Rates()
+	var rate api.Rate
+	if err == nil {
+		rate, err = rates.Current(now)
+	}
+
+	if err != nil {
+		g.log.ERROR.Println("price gate:", err)
+		res.Reason = ReasonNoPrice
+		return res
+	}
+
+	res.Price = &rate.Price
+
+	if rate.Price <= g.config.MaxPrice {
+		res.Charge = true
+		res.Reason = ReasonPrice
+		return res
+	}
+
+	if next, ok := nextCheap(rates, now, g.config.MaxPrice); ok {
+		res.NextCheap = &next
+	}
+
+	if vehicle == nil || g.config.Soc <= 0 {
+		res.Reason = ReasonWait
+		return res
+	}
+
+	soc, err := vehicle.Soc()
+	if err != nil {
+		if !errors.Is(err, api.ErrNotAvailable) {
+			g.log.DEBUG.Println("price gate: vehicle soc:", err)
+		}
+
+		res.Charge = true
+		res.Reason = ReasonSocUnknown
+		return res
+	}
+
+	res.Soc = &soc
+
+	if soc < float64(g.config.Soc) {
+		res.Charge = true
+		res.Reason = ReasonFloor
+		return res
+	}
+
+	res.Reason = ReasonWait
+	return res
+}
+
+// nextCheap returns the start of the next future rate at or below the price limit
+func nextCheap(rates api.Rates, now time.Time, limit float64) (time.Time, bool) {
+	var res time.Time
+
+	for _, r := range rates {
+		if r.Price <= limit && r.End.After(now) && (res.IsZero() || r.Start.Before(res)) {
+			res = r.Start
+		}
+	}
+
+	return res, !res.IsZero()
+}
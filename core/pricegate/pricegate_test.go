@@ -0,0 +1,88 @@
+package pricegate
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/mock"
+	"github.com/evcc-io/evcc/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// rates creates hourly rates with the given prices starting at start
+func rates(start time.Time, prices ...float64) api.Rates {
+	res := make(api.Rates, 0, len(prices))
+	for i, p := range prices {
+		slot := start.Add(time.Duration(i) * time.Hour)
+		res = append(res, api.Rate{Start: slot, End: slot.Add(time.Hour), Price: p})
+	}
+	return res
+}
+
+func TestGate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	clock := clock.NewMock()
+	now := clock.Now()
+
+	// expensive evening, cheap night
+	curve := rates(now, 0.40, 0.35, 0.20, 0.15, 0.30)
+
+	trf := mock.NewMockTariff(ctrl)
+	trf.EXPECT().Rates().Return(curve, nil).AnyTimes()
+
+	g := New(util.NewLogger("foo"), trf, Config{MaxPrice: 0.20, Soc: 30})
+	g.clock = clock
+
+	vehicle := mock.NewMockVehicle(ctrl)
+
+	// expensive, soc above floor
+	vehicle.EXPECT().Soc().Return(50.0, nil)
+	d := g.Decide(vehicle)
+	assert.False(t, d.Charge)
+	assert.Equal(t, ReasonWait, d.Reason)
+	assert.Equal(t, 0.40, *d.Price)
+	assert.Equal(t, now.Add(2*time.Hour), *d.NextCheap)
+
+	// expensive, soc below floor
+	vehicle.EXPECT().Soc().Return(20.0, nil)
+	d = g.Decide(vehicle)
+	assert.True(t, d.Charge)
+	assert.Equal(t, ReasonFloor, d.Reason)
+	assert.Equal(t, 20.0, *d.Soc)
+
+	// expensive, soc unknown
+	vehicle.EXPECT().Soc().Return(0.0, errors.New("foo"))
+	d = g.Decide(vehicle)
+	assert.True(t, d.Charge)
+	assert.Equal(t, ReasonSocUnknown, d.Reason)
+
+	// expensive, no vehicle
+	d = g.Decide(nil)
+	assert.False(t, d.Charge)
+	assert.Equal(t, ReasonWait, d.Reason)
+
+	// cheap window, soc not read
+	clock.Add(2 * time.Hour)
+	d = g.Decide(vehicle)
+	assert.True(t, d.Charge)
+	assert.Equal(t, ReasonPrice, d.Reason)
+	assert.Nil(t, d.NextCheap)
+
+	// no rate available
+	clock.Add(10 * time.Hour)
+	d = g.Decide(vehicle)
+	assert.False(t, d.Charge)
+	assert.Equal(t, ReasonNoPrice, d.Reason)
+}
+
+func TestGateDisabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	assert.Nil(t, New(util.NewLogger("foo"), mock.NewMockTariff(ctrl), Config{}))
+	assert.Nil(t, New(util.NewLogger("foo"), nil, Config{MaxPrice: 0.2}))
+}
@@ -1,34 +1,134 @@
 package prioritizer
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/core/loadpoint"
+	"golang.org/x/exp/slices"
 )
 
+// Strategy decides which of multiple loadpoints with same priority is preferred
+type Strategy string
+
+const (
+	Fixed      Strategy = "fixed"      // loadpoint priority only
+	LowestSoc  Strategy = "lowestSoc"  // prefer vehicle with lowest soc
+	RoundRobin Strategy = "roundRobin" // rotate preferred loadpoint
+)
+
+// roundRobinInterval is the time after which the preferred loadpoint rotates
+const roundRobinInterval = 15 * time.Minute
+
+// ParseStrategy parses the strategy name. Empty name defaults to fixed.
+func ParseStrategy(s string) (Strategy, error) {
+	if s == "" {
+		return Fixed, nil
+	}
+
+	for _, strategy := range []Strategy{Fixed, LowestSoc, RoundRobin} {
+		if strings.EqualFold(s, string(strategy)) {
+			return strategy, nil
+		}
+	}
+
+	return "", fmt.Errorf("invalid vehicle priority: %s", s)
+}
+
 type Prioritizer struct {
-	demand map[loadpoint.API]float64
+	clock    clock.Clock
+	strategy Strategy
+	demand   map[loadpoint.API]float64
+	soc      map[loadpoint.API]float64 // soc of connected vehicles
+	order    []loadpoint.API           // loadpoints in order of appearance
 }
 
 func New() *Prioritizer {
 	return &Prioritizer{
-		demand: make(map[loadpoint.API]float64),
+		clock:    clock.New(),
+		strategy: Fixed,
+		demand:   make(map[loadpoint.API]float64),
+		soc:      make(map[loadpoint.API]float64),
 	}
 }
 
+// WithStrategy sets the strategy for loadpoints with same priority
+func (p *Prioritizer) WithStrategy(strategy Strategy) *Prioritizer {
+	p.strategy = strategy
+	return p
+}
+
 func (p *Prioritizer) UpdateChargePowerFlexibility(lp loadpoint.API) {
+	if !slices.Contains(p.order, lp) {
+		p.order = append(p.order, lp)
+	}
+
+	if p.strategy == LowestSoc {
+		p.updateSoc(lp)
+	}
+
 	if power := lp.GetChargePowerFlexibility(); power >= 0 {
 		p.demand[lp] = power
 	}
 }
 
-func (p *Prioritizer) GetChargePowerFlexibility(lp loadpoint.API) float64 {
-	prio := lp.Priority()
+// updateSoc stores the soc of the loadpoint's connected vehicle. Loadpoints
+// without vehicle soc are not stored and thereby deprioritized.
+func (p *Prioritizer) updateSoc(lp loadpoint.API) {
+	delete(p.soc, lp)
 
+	if lp.GetStatus() == api.StatusA {
+		return
+	}
+
+	if v := lp.GetVehicle(); v != nil {
+		if soc, err := v.Soc(); err == nil {
+			p.soc[lp] = soc
+		}
+	}
+}
+
+func (p *Prioritizer) GetChargePowerFlexibility(lp loadpoint.API) float64 {
 	var reduceBy float64
-	for lp, power := range p.demand {
-		if lp.Priority() < prio {
+	for other, power := range p.demand {
+		if other != lp && p.before(lp, other) {
 			reduceBy += power
 		}
 	}
 
 	return reduceBy
 }
+
+// before determines if loadpoint a is preferred over loadpoint b
+func (p *Prioritizer) before(a, b loadpoint.API) bool {
+	if pa, pb := a.Priority(), b.Priority(); pa != pb {
+		return pa > pb
+	}
+
+	switch p.strategy {
+	case LowestSoc:
+		socA, okA := p.soc[a]
+		socB, okB := p.soc[b]
+		return okA && (!okB || socA < socB)
+
+	case RoundRobin:
+		return p.rank(a) < p.rank(b)
+
+	default:
+		return false
+	}
+}
+
+// rank returns the loadpoint's distance to the currently preferred loadpoint
+func (p *Prioritizer) rank(lp loadpoint.API) int {
+	idx := slices.Index(p.order, lp)
+	if idx < 0 {
+		return len(p.order)
+	}
+
+	slot := int(p.clock.Now().Unix() / int64(roundRobinInterval.Seconds()))
+	return (idx - slot%len(p.order) + len(p.order)) % len(p.order)
+}
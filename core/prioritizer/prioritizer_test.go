@@ -3,7 +3,10 @@ package prioritizer
 import (
 	"testing"
 
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/core/loadpoint"
+	"github.com/evcc-io/evcc/mock"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 )
@@ -34,3 +37,78 @@ func TestPrioritzer(t *testing.T) {
 	p.UpdateChargePowerFlexibility(lo)
 	assert.Equal(t, 0.0, p.GetChargePowerFlexibility(hi))
 }
+
+func TestPrioritizerLowestSoc(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	p := New().WithStrategy(LowestSoc)
+
+	lp := func(soc float64, err error) *loadpoint.MockAPI {
+		v := mock.NewMockVehicle(ctrl)
+		v.EXPECT().Soc().Return(soc, err).AnyTimes()
+
+		lp := loadpoint.NewMockAPI(ctrl)
+		lp.EXPECT().Priority().Return(0).AnyTimes()
+		lp.EXPECT().GetStatus().Return(api.StatusB).AnyTimes()
+		lp.EXPECT().GetVehicle().Return(v).AnyTimes()
+		lp.EXPECT().GetChargePowerFlexibility().Return(1e3).AnyTimes()
+
+		return lp
+	}
+
+	empty := lp(20, nil)
+	full := lp(80, nil)
+	unknown := lp(0, api.ErrNotAvailable)
+
+	for _, lp := range []loadpoint.API{empty, full, unknown} {
+		p.UpdateChargePowerFlexibility(lp)
+	}
+
+	// lowest soc takes from all others, unknown soc from none
+	assert.Equal(t, 2e3, p.GetChargePowerFlexibility(empty))
+	assert.Equal(t, 1e3, p.GetChargePowerFlexibility(full))
+	assert.Equal(t, 0.0, p.GetChargePowerFlexibility(unknown))
+}
+
+func TestPrioritizerRoundRobin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	clock := clock.NewMock()
+	p := New().WithStrategy(RoundRobin)
+	p.clock = clock
+
+	a := loadpoint.NewMockAPI(ctrl)
+	a.EXPECT().Priority().Return(0).AnyTimes()
+	a.EXPECT().GetChargePowerFlexibility().Return(1e3).AnyTimes()
+
+	b := loadpoint.NewMockAPI(ctrl)
+	b.EXPECT().Priority().Return(0).AnyTimes()
+	b.EXPECT().GetChargePowerFlexibility().Return(2e3).AnyTimes()
+
+	p.UpdateChargePowerFlexibility(a)
+	p.UpdateChargePowerFlexibility(b)
+
+	assert.Equal(t, 2e3, p.GetChargePowerFlexibility(a))
+	assert.Equal(t, 0.0, p.GetChargePowerFlexibility(b))
+
+	// preferred loadpoint rotates
+	clock.Add(roundRobinInterval)
+
+	assert.Equal(t, 0.0, p.GetChargePowerFlexibility(a))
+	assert.Equal(t, 1e3, p.GetChargePowerFlexibility(b))
+}
+
+func TestParseStrategy(t *testing.T) {
+	for in, out := range map[string]Strategy{
+		"":           Fixed,
+		"lowestsoc":  LowestSoc,
+		"roundRobin": RoundRobin,
+	} {
+		s, err := ParseStrategy(in)
+		assert.NoError(t, err)
+		assert.Equal(t, out, s)
+	}
+
+	_, err := ParseStrategy("foo")
+	assert.Error(t, err)
+}
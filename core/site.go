@@ -1,6 +1,7 @@
 package core
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math"
@@ -12,8 +13,10 @@ import (
 	"github.com/evcc-io/evcc/cmd/shutdown"
 	"github.com/evcc-io/evcc/core/coordinator"
 	"github.com/evcc-io/evcc/core/db"
+	"github.com/evcc-io/evcc/core/history"
 	"github.com/evcc-io/evcc/core/loadpoint"
 	"github.com/evcc-io/evcc/core/planner"
+	"github.com/evcc-io/evcc/core/pricegate"
 	"github.com/evcc-io/evcc/core/prioritizer"
 	"github.com/evcc-io/evcc/push"
 	serverdb "github.com/evcc-io/evcc/server/db"
@@ -21,6 +24,7 @@ import (
 	"github.com/evcc-io/evcc/tariff"
 	"github.com/evcc-io/evcc/util"
 	"github.com/evcc-io/evcc/util/telemetry"
+	"golang.org/x/exp/slices"
 )
 
 const standbyPower = 10 // consider less than 10W as charger in standby
@@ -63,6 +67,9 @@ type Site struct {
 	BufferStartSoc                    float64      `mapstructure:"bufferStartSoc"`                    // start charging on battery above this Soc
 	MaxGridSupplyWhileBatteryCharging float64      `mapstructure:"maxGridSupplyWhileBatteryCharging"` // ignore battery charging if AC consumption is above this value
 	SmartCostLimit                    float64      `mapstructure:"smartCostLimit"`                    // always charge if cost is below this value
+	VehiclePriority                   string       `mapstructure:"vehiclePriority"`                   // strategy for loadpoints with same priority
+
+	History HistoryConfig `mapstructure:"history"` // Vehicle telemetry history
 
 	// meters
 	gridMeter     api.Meter   // Grid usage meter
@@ -75,6 +82,7 @@ type Site struct {
 	coordinator *coordinator.Coordinator // Vehicles
 	prioritizer *prioritizer.Prioritizer // Power budgets
 	savings     *Savings                 // Savings
+	history     *history.Recorder        // Vehicle telemetry history
 
 	// cached state
 	gridPower    float64 // Grid power
@@ -95,6 +103,12 @@ type MetersConfig struct {
 	AuxMetersRef      []string `mapstructure:"aux"`       // Auxiliary meters
 }
 
+// HistoryConfig contains the vehicle telemetry history configuration
+type HistoryConfig struct {
+	Interval  time.Duration `mapstructure:"interval"`  // sampling interval, disabled if zero
+	Retention time.Duration `mapstructure:"retention"` // maximum age of samples kept in memory
+}
+
 // NewSiteFromConfig creates a new site
 func NewSiteFromConfig(
 	log *util.Logger,
@@ -113,8 +127,13 @@ func NewSiteFromConfig(
 	site.loadpoints = loadpoints
 	site.tariffs = tariffs
 	site.coordinator = coordinator.New(log, vehicles)
-	site.prioritizer = prioritizer.New()
+	strategy, err := prioritizer.ParseStrategy(site.VehiclePriority)
+	if err != nil {
+		return nil, err
+	}
+	site.prioritizer = prioritizer.New().WithStrategy(strategy)
 	site.savings = NewSavings(tariffs)
+	site.history = history.New(site.History.Interval, site.History.Retention)
 
 	site.restoreSettings()
 
@@ -128,9 +147,11 @@ func NewSiteFromConfig(
 	tariff := site.GetTariff(PlannerTariff)
 
 	// give loadpoints access to vehicles and database
-	for _, lp := range loadpoints {
+	for id, lp := range loadpoints {
+		lp.settings = fmt.Sprintf("lp%d.", id+1)
 		lp.coordinator = coordinator.NewAdapter(lp, site.coordinator)
 		lp.planner = planner.New(lp.log, tariff)
+		lp.priceGate = pricegate.New(lp.log, tariff, lp.PriceGate)
 
 		if serverdb.Instance != nil {
 			var err error
@@ -214,6 +235,10 @@ func NewSite() *Site {
 		log:          util.NewLogger("site"),
 		publishCache: make(map[string]any),
 		Voltage:      230, // V
+		History: HistoryConfig{
+			Interval:  15 * time.Minute,
+			Retention: 7 * 24 * time.Hour,
+		},
 	}
 
 	return lp
@@ -241,6 +266,15 @@ func (site *Site) restoreSettings() {
 	if v, err := settings.Float("site.smartCostLimit"); err == nil {
 		site.SmartCostLimit = v
 	}
+
+	var disabled []string
+	if err := settings.Json("site.vehiclesDisabled", &disabled); err == nil {
+		for _, v := range site.coordinator.GetVehicles() {
+			if slices.Contains(disabled, api.VehicleName(v)) {
+				site.coordinator.SetEnabled(v, false)
+			}
+		}
+	}
 }
 
 func meterCapabilities(name string, meter interface{}) string {
@@ -747,6 +781,7 @@ func (site *Site) prepare() {
 	site.publish("savingsSince", site.savings.Since())
 
 	site.publish("vehicles", vehicleTitles(site.GetVehicles()))
+	site.publish("vehiclesDisabled", site.disabledVehicles())
 }
 
 // Prepare attaches communication channels to site and loadpoints
@@ -787,11 +822,31 @@ func (site *Site) loopLoadpoints(next chan<- Updater) {
 	}
 }
 
+// contextSetter is implemented by vehicles whose pending requests can be cancelled
+type contextSetter interface {
+	SetContext(ctx context.Context)
+}
+
 // Run is the main control loop. It reacts to trigger events by
 // updating measurements and executing control logic.
 func (site *Site) Run(stopC chan struct{}, interval time.Duration) {
 	site.Health = NewHealth(time.Minute + interval)
 
+	// cancel pending vehicle requests on shutdown
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for _, v := range site.GetVehicles() {
+		if vv, ok := v.(contextSetter); ok {
+			vv.SetContext(ctx)
+		}
+	}
+
+	// record vehicle telemetry history
+	if site.history != nil {
+		go site.history.Run(ctx, site.GetVehicles)
+	}
+
 	loadpointChan := make(chan Updater)
 	go site.loopLoadpoints(loadpointChan)
 
@@ -1,7 +1,10 @@
 package site
 
 import (
+	"time"
+
 	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/history"
 	"github.com/evcc-io/evcc/core/loadpoint"
 )
 
@@ -34,6 +37,12 @@ type API interface {
 
 	// GetVehicles is the list of vehicles
 	GetVehicles() []api.Vehicle
+	// GetVehicleEnabled returns false if the vehicle has been disabled at runtime
+	GetVehicleEnabled(api.Vehicle) bool
+	// SetVehicleEnabled enables or disables the vehicle at runtime
+	SetVehicleEnabled(api.Vehicle, bool) error
+	// GetVehicleHistory returns the recorded telemetry of the given or all vehicles within the time window
+	GetVehicleHistory(vehicle string, from, to time.Time) history.Samples
 
 	//
 	// tariffs and costs
@@ -2,8 +2,10 @@ package core
 
 import (
 	"errors"
+	"time"
 
 	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/history"
 	"github.com/evcc-io/evcc/core/site"
 	"github.com/evcc-io/evcc/server/db/settings"
 )
@@ -129,6 +131,55 @@ func (site *Site) GetVehicles() []api.Vehicle {
 	return site.coordinator.GetVehicles()
 }
 
+// GetVehicleEnabled returns false if the vehicle has been disabled at runtime
+func (site *Site) GetVehicleEnabled(vehicle api.Vehicle) bool {
+	site.Lock()
+	defer site.Unlock()
+	return site.coordinator.Enabled(vehicle)
+}
+
+// SetVehicleEnabled enables or disables the vehicle at runtime. Disabled vehicles are ignored by the loadpoints.
+func (site *Site) SetVehicleEnabled(vehicle api.Vehicle, enable bool) error {
+	site.Lock()
+	defer site.Unlock()
+
+	site.coordinator.SetEnabled(vehicle, enable)
+
+	site.publish("vehiclesDisabled", site.disabledVehicles())
+
+	return settings.SetJson("site.vehiclesDisabled", site.disabledVehicleNames())
+}
+
+// GetVehicleHistory returns the recorded telemetry of the given or all vehicles within the time window
+func (site *Site) GetVehicleHistory(vehicle string, from, to time.Time) history.Samples {
+	if site.history == nil {
+		return make(history.Samples, 0)
+	}
+	return site.history.Export(vehicle, from, to)
+}
+
+// disabledVehicles returns the titles of vehicles disabled at runtime
+func (site *Site) disabledVehicles() []string {
+	res := make([]string, 0)
+	for _, v := range site.coordinator.GetVehicles() {
+		if !site.coordinator.Enabled(v) {
+			res = append(res, v.Title())
+		}
+	}
+	return res
+}
+
+// disabledVehicleNames returns the configured names of vehicles disabled at runtime
+func (site *Site) disabledVehicleNames() []string {
+	res := make([]string, 0)
+	for _, v := range site.coordinator.GetVehicles() {
+		if !site.coordinator.Enabled(v) {
+			res = append(res, api.VehicleName(v))
+		}
+	}
+	return res
+}
+
 // GetTariff returns the respective tariff if configured or nil
 func (site *Site) GetTariff(tariff string) api.Tariff {
 	site.Lock()
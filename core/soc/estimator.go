@@ -30,6 +30,10 @@ type Estimator struct {
 	minChargePower    float64 // Lowest charge power (just before vehicle stops charging at 100%)
 	maxChargePower    float64 // Highest charge power the battery can handle on any charger
 	maxChargeSoc      float64 // SoC at/after which maxChargePower is degressive
+
+	lastSoc    *float64 // last soc received from charger or vehicle
+	lastEnergy float64  // charged energy at last received soc in Wh
+	estimated  bool     // soc is estimated from charged energy since vehicle is unreachable
 }
 
 // NewEstimator creates new estimator
@@ -51,16 +55,63 @@ func (s *Estimator) Reset() {
 	s.prevSoc = 0
 	s.prevChargedEnergy = 0
 	s.initialSoc = 0
-	s.capacity = float64(s.vehicle.Capacity()) * 1e3  // cache to simplify debugging
+	s.capacity = usableCapacity(s.vehicle) * 1e3      // cache to simplify debugging
 	s.virtualCapacity = s.capacity / ChargeEfficiency // initial capacity taking efficiency into account
 	s.energyPerSocStep = s.virtualCapacity / 100
 	s.minChargePower = 1000  // default 1 kW
 	s.maxChargePower = 50000 // default 50 kW
 	s.maxChargeSoc = 50      // default 50%
+	s.lastSoc = nil
+	s.estimated = false
+}
+
+// usableCapacity returns the vehicle's usable capacity in kWh if available, otherwise its capacity
+func usableCapacity(vehicle api.Vehicle) float64 {
+	if bc, ok := vehicle.(api.BatteryCapacities); ok && bc.UsableCapacity() > 0 {
+		return bc.UsableCapacity()
+	}
+	return vehicle.Capacity()
+}
+
+// Estimated returns true if the vehicle is unreachable and the soc is estimated from the charged energy
+func (s *Estimator) Estimated() bool {
+	return s.estimated
+}
+
+// LastSoc returns the last soc received from charger or vehicle
+func (s *Estimator) LastSoc() (float64, bool) {
+	if s.lastSoc == nil {
+		return 0, false
+	}
+	return *s.lastSoc, true
+}
+
+// EnergySoc returns the soc estimated from the energy charged since the last received soc
+func (s *Estimator) EnergySoc(chargedEnergy float64) (float64, bool) {
+	return s.fallbackSoc(chargedEnergy)
+}
+
+// fallbackSoc estimates the soc from the energy charged since the last received soc
+func (s *Estimator) fallbackSoc(chargedEnergy float64) (float64, bool) {
+	if s.lastSoc == nil {
+		return 0, false
+	}
+
+	soc := *s.lastSoc
+	if s.energyPerSocStep > 0 {
+		soc += math.Max(chargedEnergy-s.lastEnergy, 0) / s.energyPerSocStep
+	}
+
+	return math.Min(soc, 100), true
 }
 
 // RemainingChargeDuration returns the estimated remaining duration
 func (s *Estimator) RemainingChargeDuration(targetSoc int, chargePower float64) time.Duration {
+	// vehicle charge curve takes precedence over the generic degressive model
+	if vc, ok := s.vehicle.(api.VehicleChargeCurve); ok && vc.ChargePower(s.vehicleSoc) > 0 {
+		return s.curveDuration(targetSoc, chargePower, vc.ChargePower)
+	}
+
 	const minChargeSoc = 100
 
 	dy := s.minChargePower - s.maxChargePower
@@ -92,6 +143,24 @@ func (s *Estimator) RemainingChargeDuration(targetSoc int, chargePower float64)
 	return time.Duration(float64(time.Hour) * (t1 + t2)).Round(time.Second)
 }
 
+// curveDuration returns the estimated remaining duration with charge power limited by the charge curve
+func (s *Estimator) curveDuration(targetSoc int, chargePower float64, curve func(float64) float64) time.Duration {
+	var hours float64
+
+	for soc := s.vehicleSoc; soc < float64(targetSoc); soc++ {
+		step := math.Min(1, float64(targetSoc)-soc)
+
+		power := math.Min(chargePower, curve(soc+step/2))
+		if power <= 0 {
+			return 0
+		}
+
+		hours += step / 100 * s.virtualCapacity / power
+	}
+
+	return time.Duration(float64(time.Hour) * hours).Round(time.Second)
+}
+
 // RemainingChargeEnergy returns the remaining charge energy in kWh
 func (s *Estimator) RemainingChargeEnergy(targetSoc int) float64 {
 	percentRemaining := float64(targetSoc) - s.vehicleSoc
@@ -137,20 +206,32 @@ func (s *Estimator) Soc(chargedEnergy float64) (float64, error) {
 				return 0, err
 			}
 
-			// never received a soc value
-			if s.prevSoc == 0 {
+			// vehicle unreachable, estimate from energy charged since the last received soc
+			soc, ok := s.fallbackSoc(chargedEnergy)
+			if !ok {
 				return 0, err
 			}
 
-			// recover from temporary api errors
-			f = s.prevSoc
-			s.log.WARN.Printf("vehicle soc: %v (ignored by estimator)", err)
+			s.log.WARN.Printf("vehicle soc: %v (estimated: %.1f%%)", err, soc)
+			s.vehicleSoc = soc
+			s.estimated = true
+
+			return s.vehicleSoc, nil
 		}
 
 		fetchedSoc = &f
 		s.vehicleSoc = f
 	}
 
+	// vehicle is reachable, reconcile with received soc
+	s.estimated = false
+
+	// keep the energy baseline while an unchanged, possibly outdated soc is received
+	if s.lastSoc == nil || *s.lastSoc != *fetchedSoc {
+		s.lastSoc = fetchedSoc
+		s.lastEnergy = chargedEnergy
+	}
+
 	if s.estimate && s.virtualCapacity > 0 {
 		socDelta := s.vehicleSoc - s.prevSoc
 		energyDelta := math.Max(chargedEnergy, 0) - s.prevChargedEnergy
@@ -10,6 +10,7 @@ import (
 	"github.com/evcc-io/evcc/util"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestRemainingChargeDuration(t *testing.T) {
@@ -17,7 +18,7 @@ func TestRemainingChargeDuration(t *testing.T) {
 	charger := mock.NewMockCharger(ctrl)
 	vehicle := mock.NewMockVehicle(ctrl)
 	// 9 kWh userBatCap => 10 kWh virtualBatCap
-	vehicle.EXPECT().Capacity().Return(float64(9))
+	vehicle.EXPECT().Capacity().Return(float64(9)).AnyTimes()
 
 	ce := NewEstimator(util.NewLogger("foo"), charger, vehicle, false)
 	ce.vehicleSoc = 20.0
@@ -30,6 +31,59 @@ func TestRemainingChargeDuration(t *testing.T) {
 	}
 }
 
+type curveVehicle struct {
+	*mock.MockVehicle
+}
+
+// ChargePower halves power above 50%
+func (v *curveVehicle) ChargePower(soc float64) float64 {
+	if soc > 50 {
+		return 500
+	}
+	return 1000
+}
+
+func TestRemainingChargeDurationCurve(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	charger := mock.NewMockCharger(ctrl)
+	vehicle := mock.NewMockVehicle(ctrl)
+	// 9 kWh userBatCap => 10 kWh virtualBatCap
+	vehicle.EXPECT().Capacity().Return(float64(9))
+
+	ce := NewEstimator(util.NewLogger("foo"), charger, &curveVehicle{vehicle}, false)
+	ce.vehicleSoc = 20.0
+
+	// 3h up to 50% at 1kW, 6h up to 80% at 0.5kW
+	assert.Equal(t, 9*time.Hour, ce.RemainingChargeDuration(80, 2000))
+
+	// limited by charge power
+	assert.Equal(t, 12*time.Hour, ce.RemainingChargeDuration(80, 500))
+}
+
+type unknownCurveVehicle struct {
+	*mock.MockVehicle
+}
+
+func (v *unknownCurveVehicle) ChargePower(soc float64) float64 {
+	return 0
+}
+
+func TestRemainingChargeDurationUnknownCurve(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	charger := mock.NewMockCharger(ctrl)
+	vehicle := mock.NewMockVehicle(ctrl)
+	vehicle.EXPECT().Capacity().Return(float64(9)).Times(2)
+
+	ce := NewEstimator(util.NewLogger("foo"), charger, vehicle, false)
+	ce.vehicleSoc = 20.0
+
+	uce := NewEstimator(util.NewLogger("foo"), charger, &unknownCurveVehicle{vehicle}, false)
+	uce.vehicleSoc = 20.0
+
+	// unknown charge curve falls back to the generic model
+	assert.Equal(t, ce.RemainingChargeDuration(80, 2000), uce.RemainingChargeDuration(80, 2000))
+}
+
 func TestSocEstimation(t *testing.T) {
 	type chargerStruct struct {
 		*mock.MockCharger
@@ -243,3 +297,81 @@ func TestImprovedEstimatorRemainingChargeDuration(t *testing.T) {
 		assert.Equal(t, tc.duration, ce.RemainingChargeDuration(tc.targetsoc, tc.chargePower))
 	}
 }
+
+func TestSocFallback(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	charger := mock.NewMockCharger(ctrl)
+	vehicle := mock.NewMockVehicle(ctrl)
+
+	// 9 kWh user battery capacity is converted to initial value of 10 kWh virtual capacity
+	vehicle.EXPECT().Capacity().Return(float64(9)).AnyTimes()
+
+	ce := NewEstimator(util.NewLogger("foo"), charger, vehicle, false)
+
+	// never received a soc value
+	vehicle.EXPECT().Soc().Return(0.0, errors.New("unreachable"))
+	_, err := ce.Soc(0)
+	assert.Error(t, err)
+
+	tc := []struct {
+		chargedEnergy float64
+		vehicleSoc    float64 // actual vehicle soc
+		vehicleError  error
+		soc           float64
+		estimated     bool
+	}{
+		{0, 50, nil, 50, false},
+		{1000, 60, errors.New("unreachable"), 60, true},
+		{2000, 70, errors.New("unreachable"), 70, true},
+		// reconcile with actual value
+		{2500, 74, nil, 74, false},
+		{3000, 79, errors.New("unreachable"), 79, true},
+		{6000, 100, errors.New("unreachable"), 100, true},
+	}
+
+	for _, tc := range tc {
+		t.Logf("%+v", tc)
+
+		vehicle.EXPECT().Soc().Return(tc.vehicleSoc, tc.vehicleError)
+
+		soc, err := ce.Soc(tc.chargedEnergy)
+		require.NoError(t, err)
+
+		assert.InDelta(t, tc.soc, soc, 1e-6)
+		assert.InDelta(t, tc.vehicleSoc, soc, 1, "estimate diverged from actual soc")
+		assert.Equal(t, tc.estimated, ce.Estimated())
+	}
+
+	// reset clears last known soc
+	ce.Reset()
+	vehicle.EXPECT().Soc().Return(0.0, errors.New("unreachable"))
+	_, err = ce.Soc(0)
+	assert.Error(t, err)
+	assert.False(t, ce.Estimated())
+}
+
+type capacitiesVehicle struct {
+	*mock.MockVehicle
+}
+
+func (v *capacitiesVehicle) UsableCapacity() float64 {
+	return 9
+}
+
+func (v *capacitiesVehicle) NominalCapacity() float64 {
+	return 10
+}
+
+func TestUsableCapacity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	charger := mock.NewMockCharger(ctrl)
+	vehicle := mock.NewMockVehicle(ctrl)
+
+	// configured capacity is overridden by usable capacity
+	vehicle.EXPECT().Capacity().Return(float64(20)).AnyTimes()
+
+	ce := NewEstimator(util.NewLogger("foo"), charger, &capacitiesVehicle{vehicle}, false)
+
+	// 9 kWh usable capacity is converted to 10 kWh virtual capacity
+	assert.InDelta(t, 10.0, ce.RemainingChargeEnergy(100), 1e-9)
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"time"
+)
+
+// Bulk shares a single cached request between multiple getters mapping fields of the payload
+type Bulk[T any] struct {
+	Cacheable[T]
+}
+
+// BulkCached wraps the request with a cache. It returns a `Bulk` providing typed sub-getters.
+// A zero or negative cache duration disables caching.
+func BulkCached[T any](g func() (T, error), cache time.Duration) *Bulk[T] {
+	if cache <= 0 {
+		return &Bulk[T]{Uncached(g)}
+	}
+
+	return &Bulk[T]{ResettableCached(g, cache)}
+}
+
+// WithJitter randomly varies the cache duration by up to ±jitter (e.g. 0.1 for 10%)
+func (b *Bulk[T]) WithJitter(jitter float64) *Bulk[T] {
+	if c, ok := b.Cacheable.(*cached[T]); ok {
+		c.WithJitter(jitter)
+	}
+	return b
+}
+
+// WithThreshold enables the circuit breaker after the given number of consecutive failures
+func (b *Bulk[T]) WithThreshold(threshold int) *Bulk[T] {
+	if c, ok := b.Cacheable.(*cached[T]); ok {
+		c.WithThreshold(threshold)
+	}
+	return b
+}
+
+// WithName labels the cache metrics with the given name. It has no effect if caching is disabled.
+func (b *Bulk[T]) WithName(name string) *Bulk[T] {
+	if c, ok := b.Cacheable.(*cached[T]); ok {
+		c.WithName(name)
+	}
+	return b
+}
+
+// SetCache changes the cache duration. It has no effect if caching is disabled.
+func (b *Bulk[T]) SetCache(cache time.Duration) {
+	if c, ok := b.Cacheable.(*cached[T]); ok {
+		c.SetCache(cache)
+	}
+}
+
+// bulkField creates a getter mapping the shared payload to a single value.
+// The mapping may reject the payload, e.g. if the field has not been reported.
+func bulkField[T, R any](g func() (T, error), f func(T) (R, error)) func() (R, error) {
+	return func() (R, error) {
+		res, err := g()
+		if err != nil {
+			var zero R
+			return zero, err
+		}
+
+		return f(res)
+	}
+}
+
+// Float creates a float getter from the shared payload
+func (b *Bulk[T]) Float(f func(T) (float64, error)) func() (float64, error) {
+	return bulkField(b.Get, f)
+}
+
+// Int creates an int getter from the shared payload
+func (b *Bulk[T]) Int(f func(T) (int64, error)) func() (int64, error) {
+	return bulkField(b.Get, f)
+}
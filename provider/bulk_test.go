@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulkCached(t *testing.T) {
+	type payload struct {
+		F float64
+		I int64
+	}
+
+	var calls int
+	var err error
+
+	b := BulkCached(func() (payload, error) {
+		calls++
+		return payload{1.5, 2}, err
+	}, time.Hour)
+
+	f, _ := b.Float(func(p payload) (float64, error) { return p.F, nil })()
+	i, _ := b.Int(func(p payload) (int64, error) { return p.I, nil })()
+
+	assert.Equal(t, 1.5, f)
+	assert.Equal(t, int64(2), i)
+
+	// single request shared by all getters
+	assert.Equal(t, 1, calls)
+
+	// errors are propagated with zero value
+	err = errors.New("foo")
+	b.Reset()
+
+	f, e := b.Float(func(p payload) (float64, error) { return p.F, nil })()
+	assert.Equal(t, 0.0, f)
+	assert.Equal(t, err, e)
+
+	// mapping errors are propagated
+	err = nil
+	b.Reset()
+
+	_, e = b.Int(func(p payload) (int64, error) { return 0, api.ErrNotAvailable })()
+	assert.Equal(t, api.ErrNotAvailable, e)
+}
+
+func TestBulkUncached(t *testing.T) {
+	var calls int
+
+	b := BulkCached(func() (int64, error) {
+		calls++
+		return 0, nil
+	}, 0)
+
+	for i := 0; i < 2; i++ {
+		_, _ = b.Int(func(i int64) (int64, error) { return i, nil })()
+	}
+
+	assert.Equal(t, 2, calls)
+}
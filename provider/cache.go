@@ -2,7 +2,9 @@ package provider
 
 import (
 	"errors"
+	"fmt"
 	"math"
+	"math/rand"
 	"sync"
 	"time"
 
@@ -18,8 +20,9 @@ var (
 )
 
 const (
-	reset           = "reset"
-	backoffDuration = 5 * time.Second
+	reset              = "reset"
+	backoffDuration    = 5 * time.Second
+	maxBackoffDuration = time.Hour
 )
 
 func ResetCached() {
@@ -33,7 +36,12 @@ type cached[T any] struct {
 	updated        time.Time
 	retried        time.Time
 	cache          time.Duration
+	jitter         float64       // relative random deviation of cache duration
+	ttl            time.Duration // cache duration including jitter
 	backoffCounter int
+	failures       int    // consecutive failures
+	threshold      int    // consecutive failures until getter is considered unavailable, disabled if zero
+	name           string // metrics label, e.g. the vehicle name
 	g              func() (T, error)
 	val            T
 	err            error
@@ -60,24 +68,126 @@ func ResettableCached[T any](g func() (T, error), cache time.Duration) *cached[T
 	c := &cached[T]{
 		clock: clock,
 		cache: cache,
+		ttl:   cache,
 		g:     g,
 	}
 	_ = bus.Subscribe(reset, c.Reset)
 	return c
 }
 
+// WithJitter randomly varies the cache duration by up to ±jitter (e.g. 0.1 for 10%)
+// on each refresh to spread requests of getters with identical cache durations.
+func (c *cached[T]) WithJitter(jitter float64) *cached[T] {
+	c.mux.Lock()
+	c.jitter = jitter
+	c.mux.Unlock()
+	return c
+}
+
+// WithThreshold enables the circuit breaker. Once threshold consecutive failures have occurred, the getter
+// is considered unavailable and retried with increasing back-off. A zero or negative threshold disables it.
+func (c *cached[T]) WithThreshold(threshold int) *cached[T] {
+	c.mux.Lock()
+	c.threshold = threshold
+	c.mux.Unlock()
+	return c
+}
+
+// WithName labels the cache metrics with the given name
+func (c *cached[T]) WithName(name string) *cached[T] {
+	c.mux.Lock()
+	c.name = name
+	c.mux.Unlock()
+	return c
+}
+
+// SetCache changes the cache duration. A shorter duration also shortens the current cache period.
+func (c *cached[T]) SetCache(cache time.Duration) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	c.cache = cache
+	if c.ttl > cache {
+		c.ttl = cache
+	}
+}
+
+// FailureCounter is implemented by getters tracking consecutive failures
+type FailureCounter interface {
+	Failures() int
+	Unavailable() bool
+}
+
+var _ FailureCounter = (*cached[int64])(nil)
+
+// Failures returns the number of consecutive failures
+func (c *cached[T]) Failures() int {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return c.failures
+}
+
+// Unavailable returns true once the failure threshold has been reached
+func (c *cached[T]) Unavailable() bool {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return c.open()
+}
+
+// open returns true if the circuit breaker is enabled and the failure threshold has been reached
+func (c *cached[T]) open() bool {
+	return c.threshold > 0 && c.failures >= c.threshold
+}
+
+// uncached is a Cacheable that always invokes the getter
+type uncached[T any] struct {
+	g func() (T, error)
+}
+
+// Uncached wraps a getter without caching. It returns a `Cacheable` for use where caching is disabled.
+func Uncached[T any](g func() (T, error)) Cacheable[T] {
+	return &uncached[T]{g: g}
+}
+
+func (c *uncached[T]) Get() (T, error) {
+	return c.g()
+}
+
+func (c *uncached[T]) Reset() {}
+
 func (c *cached[T]) Get() (T, error) {
 	c.mux.Lock()
 	defer c.mux.Unlock()
 
 	if c.mustUpdate() {
+		cacheRequest(c.name, false)
+
 		c.val, c.err = c.g()
 		c.updated = c.clock.Now()
 		c.retried = c.clock.Now()
 
-		if c.err == nil {
+		c.ttl = c.cache
+		if c.jitter > 0 {
+			c.ttl += time.Duration((2*rand.Float64() - 1) * c.jitter * float64(c.cache))
+		}
+
+		switch {
+		case c.err == nil:
 			c.backoffCounter = 0
+			c.failures = 0
+		case !errors.Is(c.err, api.ErrMustRetry):
+			c.failures++
+			if c.failures == c.threshold {
+				log.WARN.Printf("%d consecutive failures, backing off: %v", c.failures, c.err)
+			}
 		}
+	} else {
+		cacheRequest(c.name, true)
+	}
+
+	// circuit breaker open
+	if c.open() {
+		return c.val, fmt.Errorf("%w: %w", api.ErrNotAvailable, c.err)
 	}
 
 	return c.val, c.err
@@ -91,17 +201,33 @@ func (c *cached[T]) Reset() {
 }
 
 func (c *cached[T]) mustUpdate() bool {
-	return c.clock.Since(c.updated) > c.cache ||
+	if c.open() {
+		return c.clock.Since(c.updated) > c.breakerBackoff()
+	}
+
+	return c.clock.Since(c.updated) > c.ttl ||
 		errors.Is(c.err, api.ErrMustRetry) ||
 		c.err != nil && c.shouldRetryWithBackoff()
 }
 
-// shouldRetryWithBackoff returns true when exponential back-off duration has elapsed since last retry
+// shouldRetryWithBackoff returns true when exponential back-off duration has elapsed since last retry.
+// The back-off duration is capped at maxBackoffDuration.
 func (c *cached[T]) shouldRetryWithBackoff() bool {
-	if c.clock.Since(c.retried) > backoffDuration*time.Duration(math.Pow(2, float64(c.backoffCounter))) {
+	backoff := time.Duration(math.Min(float64(backoffDuration)*math.Pow(2, float64(c.backoffCounter)), float64(maxBackoffDuration)))
+
+	if c.clock.Since(c.retried) > backoff {
 		c.backoffCounter++
 		return true
 	}
 
 	return false
 }
+
+// breakerBackoff returns the exponentially increasing time between retries once the circuit breaker is open.
+// The back-off duration is capped at maxBackoffDuration.
+func (c *cached[T]) breakerBackoff() time.Duration {
+	base := math.Max(float64(c.cache), float64(backoffDuration))
+	exp := float64(c.failures - c.threshold + 1)
+
+	return time.Duration(math.Min(base*math.Pow(2, exp), float64(maxBackoffDuration)))
+}
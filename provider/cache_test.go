@@ -7,6 +7,7 @@ import (
 
 	"github.com/benbjohnson/clock"
 	"github.com/evcc-io/evcc/api"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -80,6 +81,37 @@ func TestCacheReset(t *testing.T) {
 	test(3)
 }
 
+func TestCacheSetCache(t *testing.T) {
+	var i int64
+	g := func() (int64, error) {
+		i++
+		return i, nil
+	}
+
+	c := ResettableCached(g, 10*time.Minute)
+	clock := clock.NewMock()
+	c.clock = clock
+
+	v, _ := c.Get()
+	assert.Equal(t, int64(1), v)
+
+	// shorter duration applies to current value
+	c.SetCache(time.Minute)
+	clock.Add(time.Minute + 1)
+	v, _ = c.Get()
+	assert.Equal(t, int64(2), v)
+
+	// longer duration applies from next refresh
+	c.SetCache(10 * time.Minute)
+	clock.Add(time.Minute + 1)
+	v, _ = c.Get()
+	assert.Equal(t, int64(3), v)
+
+	clock.Add(time.Minute + 1)
+	v, _ = c.Get()
+	assert.Equal(t, int64(3), v)
+}
+
 func TestRetryWithBackoff(t *testing.T) {
 	tests := []struct {
 		deltaTime      time.Duration
@@ -127,3 +159,195 @@ func TestRetryWithBackoff(t *testing.T) {
 		assert.Equal(t, tt.functionCalled, functionCalled)
 	}
 }
+
+func TestCacheJitter(t *testing.T) {
+	const n = 10
+	duration := 10 * time.Minute
+	clock := clock.NewMock()
+
+	updated := make([]bool, n)
+	caches := make([]*cached[int64], n)
+
+	for i := 0; i < n; i++ {
+		i := i
+		g := func() (int64, error) {
+			updated[i] = true
+			return 0, nil
+		}
+
+		caches[i] = ResettableCached(g, duration).WithJitter(0.1)
+		caches[i].clock = clock
+		_, _ = caches[i].Get()
+	}
+
+	var expired []time.Duration
+	for d := time.Duration(0); d <= 2*duration; d += time.Second {
+		for i := range caches {
+			updated[i] = false
+			_, _ = caches[i].Get()
+			if updated[i] {
+				expired = append(expired, d)
+			}
+		}
+		if len(expired) >= n {
+			break
+		}
+		clock.Add(time.Second)
+	}
+
+	assert.Len(t, expired, n)
+	for _, d := range expired {
+		assert.InDelta(t, duration, d, float64(duration)/10+float64(time.Second))
+	}
+
+	// not all getters expire in the same tick
+	assert.NotEqual(t, expired[0], expired[n-1])
+}
+
+func TestUncached(t *testing.T) {
+	var i int64
+	g := func() (int64, error) {
+		i++
+		return i, nil
+	}
+
+	c := Uncached(g)
+
+	v, _ := c.Get()
+	assert.Equal(t, int64(1), v)
+
+	v, _ = c.Get()
+	assert.Equal(t, int64(2), v)
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	const threshold = 5
+
+	var calls int
+	var fail bool
+
+	g := func() (int64, error) {
+		calls++
+		if fail {
+			return 0, api.ErrTimeout
+		}
+		return 1, nil
+	}
+
+	duration := time.Minute
+	c := ResettableCached(g, duration).WithThreshold(threshold)
+	clock := clock.NewMock()
+	c.clock = clock
+
+	fail = true
+	for i := 1; i <= threshold; i++ {
+		_, err := c.Get()
+		assert.ErrorIs(t, err, api.ErrTimeout)
+		assert.Equal(t, i == threshold, errors.Is(err, api.ErrNotAvailable))
+		clock.Add(duration + time.Second)
+	}
+
+	assert.Equal(t, threshold, calls)
+
+	// breaker open, back-off is twice the cache duration
+	_, err := c.Get()
+	assert.ErrorIs(t, err, api.ErrNotAvailable)
+	assert.Equal(t, threshold, calls)
+
+	clock.Add(duration)
+	_, err = c.Get()
+	assert.ErrorIs(t, err, api.ErrNotAvailable)
+	assert.Equal(t, threshold+1, calls)
+
+	// back-off is capped
+	c.failures = 100
+	clock.Add(maxBackoffDuration - time.Second)
+	_, _ = c.Get()
+	assert.Equal(t, threshold+1, calls)
+
+	// first success closes breaker
+	fail = false
+	clock.Add(2 * time.Second)
+	v, err := c.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), v)
+	assert.Equal(t, threshold+2, calls)
+}
+
+func TestCircuitBreakerThreshold(t *testing.T) {
+	var fail bool
+
+	g := func() (int64, error) {
+		if fail {
+			return 0, api.ErrTimeout
+		}
+		return 1, nil
+	}
+
+	duration := time.Minute
+	c := ResettableCached(g, duration).WithThreshold(2)
+	clock := clock.NewMock()
+	c.clock = clock
+
+	fail = true
+	_, err := c.Get()
+	assert.NotErrorIs(t, err, api.ErrNotAvailable)
+	assert.Equal(t, 1, c.Failures())
+	assert.False(t, c.Unavailable())
+
+	clock.Add(duration + time.Second)
+	_, err = c.Get()
+	assert.ErrorIs(t, err, api.ErrNotAvailable)
+	assert.Equal(t, 2, c.Failures())
+	assert.True(t, c.Unavailable())
+
+	// success resets the failure streak
+	fail = false
+	clock.Add(maxBackoffDuration)
+	_, err = c.Get()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, c.Failures())
+	assert.False(t, c.Unavailable())
+}
+
+func TestCircuitBreakerDisabled(t *testing.T) {
+	var calls int
+	g := func() (int64, error) {
+		calls++
+		return 0, api.ErrTimeout
+	}
+
+	c := ResettableCached(g, time.Minute)
+	clock := clock.NewMock()
+	c.clock = clock
+
+	// breaker is disabled by default
+	for i := 0; i < 10; i++ {
+		_, err := c.Get()
+		assert.NotErrorIs(t, err, api.ErrNotAvailable)
+		clock.Add(time.Hour)
+	}
+
+	assert.Equal(t, 10, calls)
+	assert.False(t, c.Unavailable())
+}
+
+func TestCacheMetrics(t *testing.T) {
+	Metrics = true
+	defer func() { Metrics = false }()
+
+	c := ResettableCached(func() (int64, error) { return 0, nil }, time.Hour).WithName("car")
+	for i := 0; i < 4; i++ {
+		_, _ = c.Get()
+	}
+
+	// metrics are recorded per name
+	stats := cacheCounterFor("car")
+	assert.Equal(t, uint64(3), stats.hits.Load())
+	assert.Equal(t, uint64(1), stats.misses.Load())
+	assert.Equal(t, 0.75, stats.ratio())
+
+	assert.Equal(t, 0.75, testutil.ToFloat64(cacheRatio.WithLabelValues("car")))
+	assert.Equal(t, 3.0, testutil.ToFloat64(cacheMetric.WithLabelValues("car", "hit")))
+	assert.Zero(t, cacheCounterFor("other").hits.Load())
+}
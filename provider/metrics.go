@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	Metrics     bool // record cache metrics
+	cacheMetric *prometheus.CounterVec
+	cacheRatio  *prometheus.GaugeVec
+	cacheStats  sync.Map // *cacheCounter by name
+)
+
+// cacheCounter counts the cached getter requests of a name
+type cacheCounter struct {
+	hits, misses atomic.Uint64
+}
+
+// ratio returns the ratio of cache hits to all requests
+func (c *cacheCounter) ratio() float64 {
+	hits, misses := c.hits.Load(), c.misses.Load()
+	if total := hits + misses; total > 0 {
+		return float64(hits) / float64(total)
+	}
+	return 0
+}
+
+func init() {
+	cacheMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "evcc",
+		Subsystem: "cache",
+		Name:      "requests_total",
+		Help:      "Total count of cached getter requests by name and result (hit/miss)",
+	}, []string{"name", "result"})
+
+	cacheRatio = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "evcc",
+		Subsystem: "cache",
+		Name:      "hit_ratio",
+		Help:      "Ratio of cached getter requests served from cache by name",
+	}, []string{"name"})
+
+	prometheus.MustRegister(cacheMetric, cacheRatio)
+}
+
+// cacheCounterFor returns the request counter of the name
+func cacheCounterFor(name string) *cacheCounter {
+	c, _ := cacheStats.LoadOrStore(name, new(cacheCounter))
+	return c.(*cacheCounter)
+}
+
+// cacheRequest records a cached getter request of the name
+func cacheRequest(name string, hit bool) {
+	if !Metrics {
+		return
+	}
+
+	c := cacheCounterFor(name)
+
+	if hit {
+		c.hits.Add(1)
+		cacheMetric.WithLabelValues(name, "hit").Inc()
+	} else {
+		c.misses.Add(1)
+		cacheMetric.WithLabelValues(name, "miss").Inc()
+	}
+
+	cacheRatio.WithLabelValues(name).Set(c.ratio())
+}
@@ -55,6 +55,23 @@ func SetString(key string, val string) {
 	}
 }
 
+// Delete removes the setting
+func Delete(key string) error {
+	idx := slices.IndexFunc(settings, func(s setting) bool {
+		return s.Key == key
+	})
+	if idx < 0 {
+		return nil
+	}
+
+	settings = slices.Delete(settings, idx, idx+1)
+
+	if db.Instance == nil {
+		return nil
+	}
+	return db.Instance.Delete(&setting{Key: key}).Error
+}
+
 func SetInt(key string, val int64) {
 	SetString(key, strconv.FormatInt(val, 10))
 }
@@ -30,3 +30,11 @@ func TestFloat(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, v, res)
 }
+
+func TestDelete(t *testing.T) {
+	SetString("delete", "foo")
+	assert.Nil(t, Delete("delete"))
+	_, err := String("delete")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Nil(t, Delete("delete"))
+}
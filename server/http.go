@@ -107,6 +107,13 @@ func (s *HTTPd) RegisterSiteHandlers(site site.API, cache *util.Cache) {
 		"smartcost":      {[]string{"POST", "OPTIONS"}, "/smartcostlimit/{value:[-0-9.]+}", floatHandler(site.SetSmartCostLimit, site.GetSmartCostLimit)},
 		"tariff":         {[]string{"GET"}, "/tariff/{tariff:[a-z]+}", tariffHandler(site)},
 		"sessions":       {[]string{"GET"}, "/sessions", sessionHandler},
+		"vehicle":        {[]string{"GET"}, "/vehicles/{name}", vehicleStateHandler(site)},
+		"vehicletoken":   {[]string{"GET", "POST", "OPTIONS"}, "/vehicles/{name}/token", vehicleTokenHandler(site)},
+		"vehiclecaps":    {[]string{"GET"}, "/vehicles/{name}/capabilities", vehicleCapabilitiesHandler(site)},
+		"vehicleresp":    {[]string{"GET"}, "/vehicles/{name}/response", vehicleResponseHandler(site)},
+		"vehiclehistory": {[]string{"GET"}, "/vehicles/{name}/history", vehicleHistoryHandler(site)},
+		"vehicleenabled": {[]string{"GET", "POST", "OPTIONS"}, "/vehicles/{name}/enabled", vehicleEnabledHandler(site)},
+		"vehicleenable2": {[]string{"POST", "OPTIONS"}, "/vehicles/{name}/enabled/{value:[a-z]+}", vehicleEnabledHandler(site)},
 		"session1":       {[]string{"PUT", "OPTIONS"}, "/session/{id:[0-9]+}", updateSessionHandler},
 		"session2":       {[]string{"DELETE", "OPTIONS"}, "/session/{id:[0-9]+}", deleteSessionHandler},
 		"telemetry":      {[]string{"GET"}, "/settings/telemetry", boolGetHandler(telemetry.Enabled)},
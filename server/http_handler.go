@@ -8,6 +8,7 @@ import (
 	"math"
 	"net/http"
 	"strconv"
+	"strings"
 	"text/template"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/evcc-io/evcc/core/site"
 	"github.com/evcc-io/evcc/server/assets"
 	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/sponsor"
 	"github.com/gorilla/mux"
 )
 
@@ -177,8 +179,42 @@ func healthHandler(site site.API) http.HandlerFunc {
 			return
 		}
 
-		w.WriteHeader(http.StatusOK)
-		fmt.Fprintln(w, "OK")
+		// vehicle api connectivity
+		status := http.StatusOK
+		var vehicles []string
+
+		for _, v := range site.GetVehicles() {
+			hr, ok := v.(api.HealthReporter)
+			if !ok {
+				continue
+			}
+
+			updated, err := hr.Health()
+
+			health := "ok"
+			if err != nil {
+				health = err.Error()
+				status = http.StatusServiceUnavailable
+			}
+
+			var last string
+			if !updated.IsZero() {
+				last = fmt.Sprintf(" (last update: %s)", updated.Format(time.RFC3339))
+			}
+
+			vehicles = append(vehicles, fmt.Sprintf("vehicle %s: %s%s", v.Title(), health, last))
+		}
+
+		w.WriteHeader(status)
+		fmt.Fprintln(w, http.StatusText(status))
+
+		if msg := sponsor.Warning(); msg != "" {
+			fmt.Fprintln(w, msg)
+		}
+
+		for _, line := range vehicles {
+			fmt.Fprintln(w, line)
+		}
 	}
 }
 
@@ -332,6 +368,11 @@ func vehicleHandler(site site.API, lp loadpoint.API) http.HandlerFunc {
 		}
 
 		v := vehicles[val-1]
+		if !site.GetVehicleEnabled(v) {
+			jsonError(w, http.StatusBadRequest, fmt.Errorf("vehicle disabled: %s", v.Title()))
+			return
+		}
+
 		lp.SetVehicle(v)
 
 		res := struct {
@@ -344,6 +385,289 @@ func vehicleHandler(site site.API, lp loadpoint.API) http.HandlerFunc {
 	}
 }
 
+// vehicleTokenHandler reports the vehicle's token expiry and scopes. POST forces a token refresh.
+// The token itself is never exposed.
+func vehicleTokenHandler(site site.API) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := mux.Vars(r)["name"]
+
+		var tc api.TokenController
+		for _, v := range site.GetVehicles() {
+			if vv, ok := v.(api.TokenController); ok && strings.EqualFold(api.VehicleName(v), name) {
+				tc = vv
+				break
+			}
+		}
+
+		if tc == nil {
+			jsonError(w, http.StatusNotFound, fmt.Errorf("vehicle not found: %s", name))
+			return
+		}
+
+		if r.Method == http.MethodPost {
+			if err := tc.ForceRefresh(); err != nil {
+				jsonError(w, http.StatusBadRequest, err)
+				return
+			}
+		}
+
+		expiry, scopes, err := tc.TokenInfo()
+		if err != nil {
+			jsonError(w, http.StatusBadRequest, err)
+			return
+		}
+
+		res := struct {
+			Expiry time.Time `json:"expiry"`
+			Scopes []string  `json:"scopes"`
+		}{
+			Expiry: expiry,
+			Scopes: scopes,
+		}
+
+		jsonResult(w, res)
+	}
+}
+
+// vehicleCapabilities lists the optional features implemented by the vehicle
+func vehicleCapabilities(v api.Vehicle) []string {
+	res := []string{"soc"}
+
+	for _, c := range []struct {
+		name string
+		ok   bool
+	}{
+		{"range", is[api.VehicleRange](v)},
+		{"odometer", is[api.VehicleOdometer](v)},
+		{"finishTime", is[api.VehicleFinishTimer](v)},
+		{"targetSoc", is[api.SocLimiter](v)},
+		{"status", is[api.ChargeState](v)},
+		{"climate", is[api.VehicleClimater](v)},
+		{"temperature", is[api.VehicleTemperature](v)},
+		{"position", is[api.VehiclePosition](v)},
+		{"present", is[api.VehiclePresent](v)},
+		{"tirePressure", is[api.VehicleTirePressure](v)},
+		{"chargeCurrent", is[api.VehicleCurrent](v)},
+		{"lifetimeEnergy", is[api.VehicleEnergy](v)},
+		{"locked", is[api.VehicleLocked](v)},
+		{"startCharge", is[api.VehicleChargeController](v)},
+		{"stopCharge", is[api.VehicleChargeController](v)},
+		{"maxCurrent", is[api.VehicleCurrentController](v)},
+		{"startClimater", is[api.VehicleClimateController](v)},
+		{"stopClimater", is[api.VehicleClimateController](v)},
+		{"lock", is[api.VehicleLock](v)},
+		{"unlock", is[api.VehicleLock](v)},
+		{"wakeUp", is[api.Resurrector](v)},
+		{"token", is[api.TokenController](v)},
+	} {
+		if c.ok {
+			res = append(res, c.name)
+		}
+	}
+
+	return res
+}
+
+// is checks if v implements T
+func is[T any](v any) bool {
+	_, ok := v.(T)
+	return ok
+}
+
+// vehicleCapabilitiesHandler reports the optional features supported by the vehicle
+func vehicleCapabilitiesHandler(site site.API) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := mux.Vars(r)["name"]
+
+		for _, v := range site.GetVehicles() {
+			if strings.EqualFold(api.VehicleName(v), name) {
+				jsonResult(w, vehicleCapabilities(v))
+				return
+			}
+		}
+
+		jsonError(w, http.StatusNotFound, fmt.Errorf("vehicle not found: %s", name))
+	}
+}
+
+// vehicleStatus is the vehicle's state assembled from the interfaces it implements.
+// Unavailable values are omitted.
+type vehicleStatus struct {
+	Title        string            `json:"title"`
+	Soc          *float64          `json:"soc,omitempty"`
+	Range        *int64            `json:"range,omitempty"`
+	Status       *api.ChargeStatus `json:"status,omitempty"`
+	Odometer     *float64          `json:"odometer,omitempty"`
+	Position     *[2]float64       `json:"position,omitempty"`
+	Capabilities []string          `json:"capabilities"`
+}
+
+// value returns a pointer to the result of fn or nil on error
+func value[T any](fn func() (T, error)) *T {
+	if res, err := fn(); err == nil {
+		return &res
+	}
+	return nil
+}
+
+// vehicleState reads the vehicle's state. Position is only included if exposed by the vehicle.
+func vehicleState(v api.Vehicle) vehicleStatus {
+	res := vehicleStatus{
+		Title:        v.Title(),
+		Soc:          value(v.Soc),
+		Capabilities: vehicleCapabilities(v),
+	}
+
+	if vv, ok := v.(api.VehicleRange); ok {
+		res.Range = value(vv.Range)
+	}
+
+	if vv, ok := v.(api.ChargeState); ok {
+		res.Status = value(vv.Status)
+	}
+
+	if vv, ok := v.(api.VehicleOdometer); ok {
+		res.Odometer = value(vv.Odometer)
+	}
+
+	if vv, ok := v.(api.VehiclePosition); ok {
+		if lat, lon, err := vv.Position(); err == nil {
+			res.Position = &[2]float64{lat, lon}
+		}
+	}
+
+	return res
+}
+
+// vehicleStateHandler returns the vehicle's state in a single response
+func vehicleStateHandler(site site.API) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := mux.Vars(r)["name"]
+
+		for _, v := range site.GetVehicles() {
+			if strings.EqualFold(api.VehicleName(v), name) {
+				jsonResult(w, vehicleState(v))
+				return
+			}
+		}
+
+		jsonError(w, http.StatusNotFound, fmt.Errorf("vehicle not found: %s", name))
+	}
+}
+
+// vehicleEnabledHandler enables or disables the vehicle at runtime. POST without value toggles the state.
+func vehicleEnabledHandler(site site.API) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		name := vars["name"]
+
+		var vehicle api.Vehicle
+		for _, v := range site.GetVehicles() {
+			if strings.EqualFold(api.VehicleName(v), name) {
+				vehicle = v
+				break
+			}
+		}
+
+		if vehicle == nil {
+			jsonError(w, http.StatusNotFound, fmt.Errorf("vehicle not found: %s", name))
+			return
+		}
+
+		if r.Method == http.MethodPost {
+			enable := !site.GetVehicleEnabled(vehicle)
+
+			if value, ok := vars["value"]; ok {
+				var err error
+				if enable, err = strconv.ParseBool(value); err != nil {
+					jsonError(w, http.StatusBadRequest, err)
+					return
+				}
+			}
+
+			if err := site.SetVehicleEnabled(vehicle, enable); err != nil {
+				jsonError(w, http.StatusBadRequest, err)
+				return
+			}
+		}
+
+		jsonResult(w, site.GetVehicleEnabled(vehicle))
+	}
+}
+
+// vehicleHistoryHandler exports the vehicle's recorded telemetry as json or csv.
+// The time window is given by the optional RFC3339 from and to query parameters.
+func vehicleHistoryHandler(site site.API) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := mux.Vars(r)["name"]
+
+		var vehicle api.Vehicle
+		for _, v := range site.GetVehicles() {
+			if strings.EqualFold(api.VehicleName(v), name) {
+				vehicle = v
+				break
+			}
+		}
+
+		if vehicle == nil {
+			jsonError(w, http.StatusNotFound, fmt.Errorf("vehicle not found: %s", name))
+			return
+		}
+
+		var from time.Time
+		to := time.Now()
+
+		q := r.URL.Query()
+		for key, ts := range map[string]*time.Time{"from": &from, "to": &to} {
+			if val := q.Get(key); val != "" {
+				t, err := time.Parse(time.RFC3339, val)
+				if err != nil {
+					jsonError(w, http.StatusBadRequest, fmt.Errorf("invalid %s: %w", key, err))
+					return
+				}
+				*ts = t
+			}
+		}
+
+		res := site.GetVehicleHistory(api.VehicleName(vehicle), from, to)
+
+		if q.Get("format") == "csv" {
+			csvResult(r.Context(), w, &res, "history-"+strings.ToLower(name))
+			return
+		}
+
+		jsonResult(w, res)
+	}
+}
+
+// vehicleResponseHandler returns the vehicle's last raw api response for debugging
+func vehicleResponseHandler(site site.API) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		name := mux.Vars(r)["name"]
+
+		for _, v := range site.GetVehicles() {
+			if rc, ok := v.(api.ResponseCapturer); ok && strings.EqualFold(api.VehicleName(v), name) {
+				res := rc.LastResponse()
+				if res == nil {
+					jsonError(w, http.StatusNotFound, errors.New("no response captured"))
+					return
+				}
+
+				// non-json responses like html error pages are returned as string
+				if !json.Valid(res) {
+					jsonResult(w, string(res))
+					return
+				}
+
+				jsonResult(w, json.RawMessage(res))
+				return
+			}
+		}
+
+		jsonError(w, http.StatusNotFound, fmt.Errorf("vehicle not found: %s", name))
+	}
+}
+
 // vehicleRemoveHandler removes vehicle
 func vehicleRemoveHandler(lp loadpoint.API) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -385,14 +709,16 @@ func planHandler(lp loadpoint.API) http.HandlerFunc {
 		}
 
 		res := struct {
-			Duration int64     `json:"duration"`
-			Plan     api.Rates `json:"plan"`
-			Unit     string    `json:"unit"`
-			Power    float64   `json:"power"`
+			Duration    int64     `json:"duration"`
+			Plan        api.Rates `json:"plan"`
+			Unit        string    `json:"unit"`
+			Power       float64   `json:"power"`
+			Unreachable bool      `json:"unreachable"`
 		}{
-			Duration: int64(requiredDuration.Seconds()),
-			Plan:     plan,
-			Power:    power,
+			Duration:    int64(requiredDuration.Seconds()),
+			Plan:        plan,
+			Power:       power,
+			Unreachable: !targetTime.IsZero() && requiredDuration > time.Until(targetTime),
 		}
 		jsonResult(w, res)
 	}
@@ -1,9 +1,19 @@
 package server
 
 import (
+	"errors"
 	"math"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/core/history"
+	"github.com/evcc-io/evcc/core/site"
+	"github.com/evcc-io/evcc/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -15,3 +25,322 @@ func TestNaNInf(t *testing.T) {
 	encodeFloats(c)
 	assert.Equal(t, map[string]any{"foo": nil, "bar": nil}, c, "NaN not encoded as nil")
 }
+
+type tokenSite struct {
+	site.API
+	vehicles []api.Vehicle
+	disabled map[api.Vehicle]bool
+	history  *history.Recorder
+}
+
+func (s *tokenSite) GetVehicles() []api.Vehicle {
+	return s.vehicles
+}
+
+func (s *tokenSite) GetVehicleEnabled(v api.Vehicle) bool {
+	return !s.disabled[v]
+}
+
+func (s *tokenSite) SetVehicleEnabled(v api.Vehicle, enable bool) error {
+	if s.disabled == nil {
+		s.disabled = make(map[api.Vehicle]bool)
+	}
+	s.disabled[v] = !enable
+	return nil
+}
+
+func (s *tokenSite) GetVehicleHistory(vehicle string, from, to time.Time) history.Samples {
+	return s.history.Export(vehicle, from, to)
+}
+
+type tokenVehicle struct {
+	*mock.MockVehicle
+	expiry    time.Time
+	refreshed bool
+}
+
+func (v *tokenVehicle) TokenInfo() (time.Time, []string, error) {
+	return v.expiry, []string{"read_battery"}, nil
+}
+
+func (v *tokenVehicle) ForceRefresh() error {
+	v.refreshed = true
+	v.expiry = v.expiry.Add(time.Hour)
+	return nil
+}
+
+func TestVehicleTokenHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mv := mock.NewMockVehicle(ctrl)
+	mv.EXPECT().Title().Return("Car").AnyTimes()
+
+	v := &tokenVehicle{MockVehicle: mv, expiry: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)}
+	h := vehicleTokenHandler(&tokenSite{vehicles: []api.Vehicle{v}})
+
+	serve := func(method, name string) *httptest.ResponseRecorder {
+		req := mux.SetURLVars(httptest.NewRequest(method, "/", nil), map[string]string{"name": name})
+		w := httptest.NewRecorder()
+		h(w, req)
+		return w
+	}
+
+	w := serve(http.MethodGet, "car")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"result":{"expiry":"2023-01-01T00:00:00Z","scopes":["read_battery"]}}`, w.Body.String())
+	assert.False(t, v.refreshed)
+
+	w = serve(http.MethodPost, "car")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"result":{"expiry":"2023-01-01T01:00:00Z","scopes":["read_battery"]}}`, w.Body.String())
+	assert.True(t, v.refreshed)
+
+	w = serve(http.MethodGet, "other")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+type capsVehicle struct {
+	*mock.MockVehicle
+}
+
+func (v *capsVehicle) Range() (int64, error) { return 0, nil }
+func (v *capsVehicle) StartCharge() error    { return nil }
+func (v *capsVehicle) StopCharge() error     { return nil }
+
+func TestVehicleCapabilities(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mv := mock.NewMockVehicle(ctrl)
+	mv.EXPECT().Title().Return("Car").AnyTimes()
+
+	assert.Equal(t, []string{"soc"}, vehicleCapabilities(mv))
+	assert.Equal(t, []string{"soc", "range", "startCharge", "stopCharge"}, vehicleCapabilities(&capsVehicle{mv}))
+	assert.Equal(t, []string{"soc", "token"}, vehicleCapabilities(&tokenVehicle{MockVehicle: mv}))
+
+	h := vehicleCapabilitiesHandler(&tokenSite{vehicles: []api.Vehicle{&capsVehicle{mv}}})
+
+	req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/", nil), map[string]string{"name": "car"})
+	w := httptest.NewRecorder()
+	h(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"result":["soc","range","startCharge","stopCharge"]}`, w.Body.String())
+}
+
+func TestVehicleEnabledHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mv := mock.NewMockVehicle(ctrl)
+	mv.EXPECT().Title().Return("Car").AnyTimes()
+
+	site := &tokenSite{vehicles: []api.Vehicle{mv}}
+	h := vehicleEnabledHandler(site)
+
+	serve := func(method string, vars map[string]string) *httptest.ResponseRecorder {
+		req := mux.SetURLVars(httptest.NewRequest(method, "/", nil), vars)
+		w := httptest.NewRecorder()
+		h(w, req)
+		return w
+	}
+
+	w := serve(http.MethodGet, map[string]string{"name": "car"})
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"result":true}`, w.Body.String())
+
+	// toggle
+	w = serve(http.MethodPost, map[string]string{"name": "car"})
+	assert.JSONEq(t, `{"result":false}`, w.Body.String())
+	assert.False(t, site.GetVehicleEnabled(mv))
+
+	// explicit value
+	w = serve(http.MethodPost, map[string]string{"name": "car", "value": "true"})
+	assert.JSONEq(t, `{"result":true}`, w.Body.String())
+
+	w = serve(http.MethodPost, map[string]string{"name": "car", "value": "foo"})
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = serve(http.MethodGet, map[string]string{"name": "other"})
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestVehicleHistoryHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mv := mock.NewMockVehicle(ctrl)
+	mv.EXPECT().Title().Return("Car").AnyTimes()
+	mv.EXPECT().Soc().Return(42.0, nil)
+
+	rec := history.New(time.Minute, time.Hour)
+	rec.Record(mv)
+
+	h := vehicleHistoryHandler(&tokenSite{vehicles: []api.Vehicle{mv}, history: rec})
+
+	serve := func(name, query string) *httptest.ResponseRecorder {
+		req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/?"+query, nil), map[string]string{"name": name})
+		w := httptest.NewRecorder()
+		h(w, req)
+		return w
+	}
+
+	w := serve("car", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"vehicle":"Car","soc":42}`)
+
+	w = serve("car", "format=csv")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/csv", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Body.String(), "Timestamp,Vehicle,Soc (%),Range (km),Odometer (km)\n")
+	assert.Contains(t, w.Body.String(), ",Car,42,,\n")
+
+	// empty time window
+	w = serve("car", "from="+time.Now().Add(time.Hour).Format(time.RFC3339))
+	assert.JSONEq(t, `{"result":[]}`, w.Body.String())
+
+	w = serve("car", "to=foo")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = serve("other", "")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+type stateVehicle struct {
+	*mock.MockVehicle
+}
+
+func (v *stateVehicle) Range() (int64, error)             { return 0, api.ErrNotAvailable }
+func (v *stateVehicle) Status() (api.ChargeStatus, error) { return api.StatusC, nil }
+func (v *stateVehicle) Odometer() (float64, error)        { return 12345, nil }
+
+func TestVehicleStateHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mv := mock.NewMockVehicle(ctrl)
+	mv.EXPECT().Title().Return("Car").AnyTimes()
+	mv.EXPECT().Soc().Return(55.0, nil)
+
+	h := vehicleStateHandler(&tokenSite{vehicles: []api.Vehicle{&stateVehicle{mv}}})
+
+	serve := func(name string) *httptest.ResponseRecorder {
+		req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/", nil), map[string]string{"name": name})
+		w := httptest.NewRecorder()
+		h(w, req)
+		return w
+	}
+
+	// range unavailable, no position
+	w := serve("car")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"result":{"title":"Car","soc":55,"status":"C","odometer":12345,"capabilities":["soc","range","odometer","status"]}}`, w.Body.String())
+
+	w = serve("other")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+type responseVehicle struct {
+	*mock.MockVehicle
+	res []byte
+}
+
+func (v *responseVehicle) LastResponse() []byte {
+	return v.res
+}
+
+func TestVehicleResponseHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mv := mock.NewMockVehicle(ctrl)
+	mv.EXPECT().Title().Return("Car").AnyTimes()
+
+	v := &responseVehicle{MockVehicle: mv}
+	h := vehicleResponseHandler(&tokenSite{vehicles: []api.Vehicle{v}})
+
+	serve := func() *httptest.ResponseRecorder {
+		req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/", nil), map[string]string{"name": "car"})
+		w := httptest.NewRecorder()
+		h(w, req)
+		return w
+	}
+
+	w := serve()
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	v.res = []byte(`{"level":42}`)
+	w = serve()
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"result":{"level":42}}`, w.Body.String())
+
+	// non-json response
+	v.res = []byte(`<html>Bad Gateway</html>`)
+	w = serve()
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"result":"<html>Bad Gateway</html>"}`, w.Body.String())
+}
+
+type namedVehicle struct {
+	*mock.MockVehicle
+	name string
+}
+
+func (v *namedVehicle) Name() string {
+	return v.name
+}
+
+func TestVehicleHandlerName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mv := mock.NewMockVehicle(ctrl)
+	mv.EXPECT().Title().Return("My Car").AnyTimes()
+
+	h := vehicleCapabilitiesHandler(&tokenSite{vehicles: []api.Vehicle{&namedVehicle{mv, "car"}}})
+
+	serve := func(name string) *httptest.ResponseRecorder {
+		req := mux.SetURLVars(httptest.NewRequest(http.MethodGet, "/", nil), map[string]string{"name": name})
+		w := httptest.NewRecorder()
+		h(w, req)
+		return w
+	}
+
+	// vehicles are matched by configured name, not by title
+	assert.Equal(t, http.StatusOK, serve("car").Code)
+	assert.Equal(t, http.StatusNotFound, serve("My Car").Code)
+}
+
+type healthSite struct {
+	tokenSite
+}
+
+func (s *healthSite) Healthy() bool {
+	return true
+}
+
+type healthVehicle struct {
+	*mock.MockVehicle
+	err error
+}
+
+func (v *healthVehicle) Health() (time.Time, error) {
+	return time.Time{}, v.err
+}
+
+func TestHealthHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	mv := mock.NewMockVehicle(ctrl)
+	mv.EXPECT().Title().Return("car").AnyTimes()
+
+	v := &healthVehicle{MockVehicle: mv}
+	h := healthHandler(&healthSite{tokenSite{vehicles: []api.Vehicle{v}}})
+
+	w := httptest.NewRecorder()
+	h(w, httptest.NewRequest(http.MethodGet, "/health", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "vehicle car: ok")
+
+	// unhealthy vehicle
+	v.err = errors.New("offline")
+
+	w = httptest.NewRecorder()
+	h(w, httptest.NewRequest(http.MethodGet, "/health", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Contains(t, w.Body.String(), "vehicle car: offline")
+}
@@ -1,14 +1,25 @@
 package util
 
 import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/mitchellh/mapstructure"
 )
 
+// maxSuggestDistance is the maximum edit distance for suggesting a known key
+const maxSuggestDistance = 2
+
 // DecodeOther uses mapstructure to decode into target structure. Unused keys cause errors.
+// All decoding errors and unused keys are reported at once.
 func DecodeOther(other, cc interface{}) error {
+	var md mapstructure.Metadata
+
 	decoderConfig := &mapstructure.DecoderConfig{
 		Result:           cc,
-		ErrorUnused:      true,
+		Metadata:         &md,
 		WeaklyTypedInput: true,
 		DecodeHook: mapstructure.ComposeDecodeHookFunc(
 			mapstructure.StringToTimeDurationHookFunc(),
@@ -18,7 +29,7 @@ func DecodeOther(other, cc interface{}) error {
 
 	decoder, err := mapstructure.NewDecoder(decoderConfig)
 	if err == nil {
-		err = decoder.Decode(other)
+		err = errors.Join(decoder.Decode(other), unusedError(md))
 	}
 
 	if err != nil {
@@ -28,6 +39,92 @@ func DecodeOther(other, cc interface{}) error {
 	return err
 }
 
+// unusedError reports unused keys, suggesting similar known keys
+func unusedError(md mapstructure.Metadata) error {
+	known := append(append([]string{}, md.Keys...), md.Unset...)
+
+	unused := append([]string{}, md.Unused...)
+	sort.Strings(unused)
+
+	var errs []error
+	for _, key := range unused {
+		if s := suggest(key, known); s != "" {
+			errs = append(errs, fmt.Errorf("invalid key: %s (did you mean %s?)", key, s))
+		} else {
+			errs = append(errs, fmt.Errorf("invalid key: %s", key))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// suggest returns the known key on the same level closest to the unused key
+func suggest(key string, known []string) string {
+	prefix, name := splitKey(key)
+
+	var (
+		res  string
+		best = maxSuggestDistance + 1
+	)
+
+	for _, k := range known {
+		kp, kn := splitKey(k)
+		if !strings.EqualFold(prefix, kp) {
+			continue
+		}
+
+		if d := levenshtein(strings.ToLower(name), strings.ToLower(kn)); d < best {
+			res, best = strings.ToLower(k), d
+		}
+	}
+
+	return res
+}
+
+// splitKey splits a nested key into parent and name
+func splitKey(key string) (string, string) {
+	if i := strings.LastIndex(key, "."); i >= 0 {
+		return key[:i], key[i+1:]
+	}
+	return "", key
+}
+
+// levenshtein returns the edit distance between a and b
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		cur := make([]int, len(b)+1)
+		cur[0] = i
+
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = prev[j-1] + cost
+			if d := prev[j] + 1; d < cur[j] {
+				cur[j] = d
+			}
+			if d := cur[j-1] + 1; d < cur[j] {
+				cur[j] = d
+			}
+		}
+
+		prev = cur
+	}
+
+	return prev[len(b)]
+}
+
+// NewConfigError wraps err as configuration error
+func NewConfigError(err error) *ConfigError {
+	return &ConfigError{err}
+}
+
 // ConfigError wraps yaml configuration errors from mapstructure
 type ConfigError struct {
 	err error
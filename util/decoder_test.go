@@ -34,3 +34,26 @@ func TestDecodeNil(t *testing.T) {
 		User, Password string
 	}{}, dst)
 }
+
+func TestDecodeOtherUnused(t *testing.T) {
+	var dst struct {
+		Cache       string
+		Credentials struct {
+			ID, Secret string
+		}
+	}
+
+	err := DecodeOther(map[string]any{
+		"cahce":       "1m",
+		"foo":         "bar",
+		"credentials": map[string]any{"idd": "id"},
+	}, &dst)
+
+	var ce *ConfigError
+	assert.ErrorAs(t, err, &ce)
+
+	// all problems reported at once
+	assert.EqualError(t, err, "invalid key: Credentials.idd (did you mean credentials.id?)\n"+
+		"invalid key: cahce (did you mean cache?)\n"+
+		"invalid key: foo")
+}
@@ -0,0 +1,4 @@
+package util
+
+// DryRun disables charge control. Intended actions are logged instead of being executed.
+var DryRun bool
@@ -0,0 +1,18 @@
+package util
+
+import "math"
+
+// earthRadius is the mean earth radius in m
+const earthRadius = 6371e3
+
+// Distance returns the great-circle distance in m between two coordinates using the haversine formula
+func Distance(lat1, lon1, lat2, lon2 float64) float64 {
+	const rad = math.Pi / 180
+
+	dLat := (lat2 - lat1) * rad
+	dLon := (lon2 - lon1) * rad
+
+	a := math.Pow(math.Sin(dLat/2), 2) + math.Cos(lat1*rad)*math.Cos(lat2*rad)*math.Pow(math.Sin(dLon/2), 2)
+
+	return 2 * earthRadius * math.Asin(math.Sqrt(a))
+}
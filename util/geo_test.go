@@ -0,0 +1,21 @@
+package util
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDistance(t *testing.T) {
+	tc := []struct {
+		lat1, lon1, lat2, lon2, dist float64
+	}{
+		{52.5200, 13.4050, 52.5200, 13.4050, 0},
+		{52.5200, 13.4050, 48.1351, 11.5820, 504415}, // Berlin - Munich
+	}
+
+	for _, tc := range tc {
+		if d := Distance(tc.lat1, tc.lon1, tc.lat2, tc.lon2); math.Abs(d-tc.dist) > 1 {
+			t.Errorf("expected %.0fm, got %.0fm", tc.dist, d)
+		}
+	}
+}
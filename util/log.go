@@ -31,6 +31,7 @@ var LogAreaPadding = 6
 type Logger struct {
 	*jww.Notepad
 	*Redactor
+	area string
 }
 
 // NewLogger creates a logger with the given log area and adds it to the registry
@@ -54,6 +55,7 @@ func NewLogger(area string) *Logger {
 	logger := &Logger{
 		Notepad:  notepad,
 		Redactor: redactor,
+		area:     area,
 	}
 
 	// capture loggers created after uiChan is initialized
@@ -72,6 +74,11 @@ func (l *Logger) Redact(items ...string) *Logger {
 	return l
 }
 
+// Area returns the logger's log area
+func (l *Logger) Area() string {
+	return l.area
+}
+
 // Loggers invokes callback for each configured logger
 func Loggers(cb func(string, *Logger)) {
 	for name, logger := range loggers {
@@ -0,0 +1,182 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// https://www.rfc-editor.org/rfc/rfc8628
+
+const deviceGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+
+// DeviceCode is the device authorization response
+type DeviceCode struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete,omitempty"`
+	ExpiresIn               int64  `json:"expires_in"`
+	Interval                int64  `json:"interval,omitempty"`
+}
+
+// deviceError is the device access token error response
+type deviceError struct {
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+}
+
+func (e *deviceError) Error() string {
+	if e.Description != "" {
+		return fmt.Sprintf("%s: %s", e.Code, e.Description)
+	}
+	return e.Code
+}
+
+// DeviceFlow implements the OAuth2 device authorization grant
+type DeviceFlow struct {
+	oc            *oauth2.Config
+	deviceAuthURL string
+	client        *http.Client
+	interval      time.Duration // default polling interval
+}
+
+// NewDeviceFlow creates a device authorization flow for given config and device authorization endpoint.
+// Tokens are obtained from the config's token endpoint.
+func NewDeviceFlow(oc *oauth2.Config, deviceAuthURL string, client *http.Client) *DeviceFlow {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &DeviceFlow{
+		oc:            oc,
+		deviceAuthURL: deviceAuthURL,
+		client:        client,
+		interval:      5 * time.Second,
+	}
+}
+
+func (f *DeviceFlow) post(ctx context.Context, uri string, data url.Values) (*http.Response, error) {
+	data.Set("client_id", f.oc.ClientID)
+	if f.oc.ClientSecret != "" {
+		data.Set("client_secret", f.oc.ClientSecret)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, strings.NewReader(data.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	return f.client.Do(req)
+}
+
+// DeviceCode requests the device and user code. The user code and verification uri must be displayed to the user.
+func (f *DeviceFlow) DeviceCode(ctx context.Context) (*DeviceCode, error) {
+	data := url.Values{}
+	if len(f.oc.Scopes) > 0 {
+		data.Set("scope", strings.Join(f.oc.Scopes, " "))
+	}
+
+	resp, err := f.post(ctx, f.deviceAuthURL, data)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("device authorization: unexpected status: %d", resp.StatusCode)
+	}
+
+	var res DeviceCode
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return nil, err
+	}
+
+	if res.DeviceCode == "" {
+		return nil, errors.New("device authorization: missing device code")
+	}
+
+	return &res, nil
+}
+
+// Token polls the token endpoint until the user has authorized the device, the device code expires or the context is cancelled
+func (f *DeviceFlow) Token(ctx context.Context, dc *DeviceCode) (*oauth2.Token, error) {
+	interval := f.interval
+	if dc.Interval > 0 {
+		interval = time.Duration(dc.Interval) * time.Second
+	}
+
+	if dc.ExpiresIn > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(dc.ExpiresIn)*time.Second)
+		defer cancel()
+	}
+
+	data := url.Values{
+		"grant_type":  {deviceGrantType},
+		"device_code": {dc.DeviceCode},
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+
+		token, err := f.token(ctx, data)
+		if err == nil {
+			return token, nil
+		}
+
+		var de *deviceError
+		if !errors.As(err, &de) {
+			return nil, err
+		}
+
+		switch de.Code {
+		case "authorization_pending":
+		case "slow_down":
+			interval += 5 * time.Second
+		default:
+			return nil, err
+		}
+	}
+}
+
+func (f *DeviceFlow) token(ctx context.Context, data url.Values) (*oauth2.Token, error) {
+	resp, err := f.post(ctx, f.oc.Endpoint.TokenURL, data)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var res deviceError
+		if err := json.NewDecoder(resp.Body).Decode(&res); err != nil || res.Code == "" {
+			return nil, fmt.Errorf("device token: unexpected status: %d", resp.StatusCode)
+		}
+		return nil, &res
+	}
+
+	var token Token
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return nil, err
+	}
+
+	if token.AccessToken == "" {
+		return nil, errors.New("device token: missing access token")
+	}
+
+	return (*oauth2.Token)(&token), nil
+}
@@ -0,0 +1,73 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+func TestDeviceFlow(t *testing.T) {
+	var polls int
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/device", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(DeviceCode{
+			DeviceCode:      "device",
+			UserCode:        "user",
+			VerificationURI: "https://example.com/device",
+			ExpiresIn:       60,
+			Interval:        0,
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("device_code") != "device" || r.FormValue("grant_type") != deviceGrantType {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(deviceError{Code: "invalid_grant"})
+			return
+		}
+
+		if polls++; polls < 2 {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(deviceError{Code: "authorization_pending"})
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"access_token":"access","refresh_token":"refresh","expires_in":3600}`))
+	})
+
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	oc := &oauth2.Config{
+		ClientID: "id",
+		Endpoint: oauth2.Endpoint{TokenURL: srv.URL + "/token"},
+	}
+
+	flow := NewDeviceFlow(oc, srv.URL+"/device", nil)
+
+	dc, err := flow.DeviceCode(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if dc.UserCode != "user" {
+		t.Error("unexpected user code", dc.UserCode)
+	}
+
+	// speed up polling
+	dc.Interval = 0
+	flow.interval = 0
+
+	token, err := flow.Token(context.Background(), dc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if token.AccessToken != "access" || token.Expiry.IsZero() {
+		t.Error("unexpected token", token)
+	}
+}
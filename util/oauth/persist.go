@@ -0,0 +1,39 @@
+package oauth
+
+import (
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+// PersistingTokenSource wraps a token source and invokes the persist callback whenever a new token is issued
+type PersistingTokenSource struct {
+	mu      sync.Mutex
+	ts      oauth2.TokenSource
+	persist func(*oauth2.Token) error
+	token   *oauth2.Token
+}
+
+// NewPersistingTokenSource creates a token source that persists new tokens using the persist callback
+func NewPersistingTokenSource(ts oauth2.TokenSource, persist func(*oauth2.Token) error) oauth2.TokenSource {
+	return &PersistingTokenSource{ts: ts, persist: persist}
+}
+
+func (ts *PersistingTokenSource) Token() (*oauth2.Token, error) {
+	token, err := ts.ts.Token()
+	if err != nil {
+		return token, err
+	}
+
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	if ts.token == nil || ts.token.AccessToken != token.AccessToken || ts.token.RefreshToken != token.RefreshToken {
+		// persistence failure must not break authentication, token will be persisted on next refresh
+		if err := ts.persist(token); err == nil {
+			ts.token = token
+		}
+	}
+
+	return token, nil
+}
@@ -0,0 +1,50 @@
+package oauth
+
+import (
+	"io"
+	"net/http"
+)
+
+// RetryTransport is an http.RoundTripper retrying requests once after refreshing the token
+// if they are rejected as unauthorized, e.g. due to the access token expiring in flight.
+// Unauthorized requests have not been processed and can safely be replayed. Requests with
+// bodies that cannot be replayed are not retried.
+type RetryTransport struct {
+	// Refresh forces a token refresh. The original response is returned if refresh fails.
+	Refresh func() error
+
+	// Base is the authenticating RoundTripper used to make HTTP requests.
+	Base http.RoundTripper
+}
+
+// RoundTrip executes the request and retries it once if unauthorized
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.Base.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return resp, nil
+	}
+
+	if err := t.Refresh(); err != nil {
+		return resp, nil
+	}
+
+	// per RoundTripper contract the original request is not modified
+	req2 := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+		req2.Body = body
+	}
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	// retried only once, a repeated 401 is returned as is
+	return t.Base.RoundTrip(req2)
+}
@@ -0,0 +1,84 @@
+package oauth
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+func TestRetryTransport(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		// token expired in flight
+		if r.Header.Get("Authorization") != "Bearer new" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		b, _ := io.ReadAll(r.Body)
+		_, _ = w.Write(b)
+	}))
+	defer srv.Close()
+
+	token := &oauth2.Token{AccessToken: "old", TokenType: "Bearer"}
+
+	var refreshed int
+	client := &http.Client{
+		Transport: &RetryTransport{
+			Refresh: func() error {
+				refreshed++
+				token = &oauth2.Token{AccessToken: "new", TokenType: "Bearer"}
+				return nil
+			},
+			Base: &oauth2.Transport{
+				Source: tokenSourceFunc(func() (*oauth2.Token, error) { return token, nil }),
+			},
+		},
+	}
+
+	resp, err := client.Post(srv.URL, "text/plain", strings.NewReader("payload"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	b, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK || string(b) != "payload" {
+		t.Errorf("unexpected response: %d %s", resp.StatusCode, b)
+	}
+
+	if requests != 2 || refreshed != 1 {
+		t.Errorf("unexpected requests: %d, refreshes: %d", requests, refreshed)
+	}
+
+	// no retry loop if refreshed token is rejected, too
+	token = &oauth2.Token{AccessToken: "invalid", TokenType: "Bearer"}
+	client.Transport.(*RetryTransport).Refresh = func() error {
+		refreshed++
+		return nil
+	}
+
+	requests, refreshed = 0, 0
+
+	resp, err = client.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusUnauthorized || requests != 2 || refreshed != 1 {
+		t.Errorf("unexpected retry: %d, requests: %d, refreshes: %d", resp.StatusCode, requests, refreshed)
+	}
+}
+
+type tokenSourceFunc func() (*oauth2.Token, error)
+
+func (f tokenSourceFunc) Token() (*oauth2.Token, error) {
+	return f()
+}
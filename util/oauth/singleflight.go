@@ -0,0 +1,51 @@
+package oauth
+
+import (
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+// SingleFlightTokenSource coalesces concurrent token requests into a single call of the wrapped token source.
+// This prevents rotating refresh tokens from being invalidated by concurrent refreshes.
+type SingleFlightTokenSource struct {
+	mu   sync.Mutex
+	ts   oauth2.TokenSource
+	call *tokenCall
+}
+
+// tokenCall is a token request in flight
+type tokenCall struct {
+	done  chan struct{}
+	token *oauth2.Token
+	err   error
+}
+
+// NewSingleFlightTokenSource creates a token source sharing the result of concurrent token requests
+func NewSingleFlightTokenSource(ts oauth2.TokenSource) oauth2.TokenSource {
+	return &SingleFlightTokenSource{ts: ts}
+}
+
+func (ts *SingleFlightTokenSource) Token() (*oauth2.Token, error) {
+	ts.mu.Lock()
+
+	// wait for the request in flight
+	if c := ts.call; c != nil {
+		ts.mu.Unlock()
+		<-c.done
+		return c.token, c.err
+	}
+
+	c := &tokenCall{done: make(chan struct{})}
+	ts.call = c
+	ts.mu.Unlock()
+
+	c.token, c.err = ts.ts.Token()
+
+	ts.mu.Lock()
+	ts.call = nil
+	ts.mu.Unlock()
+	close(c.done)
+
+	return c.token, c.err
+}
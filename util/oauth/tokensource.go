@@ -28,18 +28,31 @@ func (ts *TokenSource) Token() (*oauth2.Token, error) {
 	defer ts.mu.Unlock()
 	var err error
 	if ts.token == nil || time.Until(ts.token.Expiry) < time.Minute {
-		var token *oauth2.Token
-		if token, err = ts.refresher.RefreshToken(ts.token); err == nil {
-			if token.AccessToken == "" {
-				err = errors.New("token refresh failed to obtain access token")
-			} else {
-				err = ts.mergeToken(token)
-			}
-		}
+		err = ts.refresh()
 	}
 	return ts.token, err
 }
 
+// Refresh refreshes the token regardless of its expiry
+func (ts *TokenSource) Refresh() (*oauth2.Token, error) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	err := ts.refresh()
+	return ts.token, err
+}
+
+func (ts *TokenSource) refresh() error {
+	token, err := ts.refresher.RefreshToken(ts.token)
+	if err == nil {
+		if token.AccessToken == "" {
+			err = errors.New("token refresh failed to obtain access token")
+		} else {
+			err = ts.mergeToken(token)
+		}
+	}
+	return err
+}
+
 // mergeToken updates a token while preventing wiping the refresh token
 func (ts *TokenSource) mergeToken(t *oauth2.Token) error {
 	return mergo.Merge(ts.token, t, mergo.WithOverride)
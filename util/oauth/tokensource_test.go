@@ -1,7 +1,10 @@
 package oauth
 
 import (
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"golang.org/x/oauth2"
 )
@@ -29,3 +32,101 @@ func TestMerge(t *testing.T) {
 		t.Error("unexpected refresh token", ts.token)
 	}
 }
+
+type tokenSource []*oauth2.Token
+
+func (ts *tokenSource) Token() (*oauth2.Token, error) {
+	token := (*ts)[0]
+	if len(*ts) > 1 {
+		*ts = (*ts)[1:]
+	}
+	return token, nil
+}
+
+func TestPersist(t *testing.T) {
+	ts := &tokenSource{
+		{AccessToken: "access", RefreshToken: "refresh"},
+		{AccessToken: "access", RefreshToken: "refresh"},
+		{AccessToken: "new", RefreshToken: "refresh"},
+	}
+
+	var persisted []*oauth2.Token
+	pts := NewPersistingTokenSource(ts, func(token *oauth2.Token) error {
+		persisted = append(persisted, token)
+		return nil
+	})
+
+	for i := 0; i < 3; i++ {
+		if _, err := pts.Token(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if len(persisted) != 2 {
+		t.Fatal("unexpected persisted tokens", persisted)
+	}
+	if persisted[1].AccessToken != "new" {
+		t.Error("unexpected access token", persisted[1])
+	}
+}
+
+type refresher int
+
+func (r *refresher) RefreshToken(_ *oauth2.Token) (*oauth2.Token, error) {
+	*r++
+	return &oauth2.Token{AccessToken: "new", Expiry: time.Now().Add(time.Hour)}, nil
+}
+
+func TestForceRefresh(t *testing.T) {
+	var r refresher
+	ts := RefreshTokenSource(&oauth2.Token{
+		AccessToken: "access",
+		Expiry:      time.Now().Add(time.Hour),
+	}, &r).(*TokenSource)
+
+	// valid token is not refreshed
+	if token, err := ts.Token(); err != nil || token.AccessToken != "access" || r != 0 {
+		t.Error("unexpected refresh", token, err)
+	}
+
+	if token, err := ts.Refresh(); err != nil || token.AccessToken != "new" || r != 1 {
+		t.Error("expected refresh", token, err)
+	}
+}
+
+type blockingSource struct {
+	calls   int32
+	release chan struct{}
+}
+
+func (ts *blockingSource) Token() (*oauth2.Token, error) {
+	atomic.AddInt32(&ts.calls, 1)
+	<-ts.release
+	return &oauth2.Token{AccessToken: "new"}, nil
+}
+
+func TestSingleFlight(t *testing.T) {
+	bs := &blockingSource{release: make(chan struct{})}
+	ts := NewSingleFlightTokenSource(bs)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if token, err := ts.Token(); err != nil || token.AccessToken != "new" {
+				t.Error("unexpected token", token, err)
+			}
+		}()
+	}
+
+	// give all callers time to join the request in flight
+	time.Sleep(100 * time.Millisecond)
+
+	close(bs.release)
+	wg.Wait()
+
+	if calls := atomic.LoadInt32(&bs.calls); calls != 1 {
+		t.Error("unexpected calls", calls)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"time"
 )
 
 var (
@@ -37,7 +39,11 @@ func NewStatusError(resp *http.Response) StatusError {
 }
 
 func (e StatusError) Error() string {
-	return fmt.Sprintf("unexpected status: %d (%s)", e.resp.StatusCode, http.StatusText(e.resp.StatusCode))
+	msg := fmt.Sprintf("unexpected status: %d (%s)", e.resp.StatusCode, http.StatusText(e.resp.StatusCode))
+	if d, ok := e.RetryAfter(); ok {
+		msg += fmt.Sprintf(", retry in %v", d.Round(time.Second))
+	}
+	return msg
 }
 
 // Response returns the response with the unexpected error
@@ -60,6 +66,44 @@ func (e StatusError) HasStatus(codes ...int) bool {
 	return false
 }
 
+// RetryAfter returns the delay requested by the server using either the Retry-After
+// or the X-RateLimit-Reset header if the rate limit is exhausted
+func (e StatusError) RetryAfter() (time.Duration, bool) {
+	if d, ok := retryAfter(e.resp); ok {
+		return d, true
+	}
+
+	if remaining, ok := e.RateLimitRemaining(); ok && remaining == 0 {
+		if ts, ok := e.RateLimitReset(); ok {
+			return time.Until(ts), true
+		}
+	}
+
+	return 0, false
+}
+
+// RateLimitRemaining returns the number of remaining requests from the X-RateLimit-Remaining header
+func (e StatusError) RateLimitRemaining() (int, bool) {
+	val, err := strconv.Atoi(e.resp.Header.Get("X-RateLimit-Remaining"))
+	return val, err == nil
+}
+
+// RateLimitReset returns the time the rate limit resets from the X-RateLimit-Reset header.
+// The header is accepted both as unix timestamp and as delay in seconds.
+func (e StatusError) RateLimitReset() (time.Time, bool) {
+	val, err := strconv.ParseInt(e.resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	// values below one year are considered relative
+	if val < 365*24*3600 {
+		return time.Now().Add(time.Duration(val) * time.Second), true
+	}
+
+	return time.Unix(val, 0), true
+}
+
 // ResponseError turns an HTTP status code into an error
 func ResponseError(resp *http.Response) error {
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
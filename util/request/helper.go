@@ -1,8 +1,15 @@
 package request
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/evcc-io/evcc/util"
@@ -12,9 +19,26 @@ import (
 // Timeout is the default request timeout used by the Helper
 var Timeout = 10 * time.Second
 
+// UserAgent is the default user agent, extended by the version at startup
+var UserAgent = "evcc"
+
+// MaxRetryAfter is the longest Retry-After delay waited for before retrying a request
+var MaxRetryAfter = time.Minute
+
 // Helper provides utility primitives
 type Helper struct {
 	*http.Client
+	attempts int           // retry attempts for idempotent requests
+	backoff  time.Duration // initial retry backoff
+	capture  *capture      // last decoded json response, nil if disabled
+	maxSize  int64         // maximum response body size, unlimited if zero
+}
+
+// capture holds the last decoded json response
+type capture struct {
+	mu   sync.Mutex
+	body []byte
+	keys []string // additional redacted key parts
 }
 
 // NewClient creates http client with default transport
@@ -28,13 +52,185 @@ func NewClient(log *util.Logger) *http.Client {
 // NewHelper creates http helper for simplified PUT GET logic
 func NewHelper(log *util.Logger) *Helper {
 	return &Helper{
-		Client: NewClient(log),
+		Client:  NewClient(log),
+		maxSize: MaxResponseSize,
+	}
+}
+
+// WithMaxResponseSize limits the size of response bodies read by the helper.
+// Reading a larger body fails with ErrResponseTooLarge. Zero disables the limit.
+func (r *Helper) WithMaxResponseSize(max int64) *Helper {
+	r.maxSize = max
+	return r
+}
+
+// WithRetry enables retrying idempotent requests up to attempts times on
+// transient errors (HTTP 429, 502, 503, 504) with exponential backoff.
+// A Retry-After response header takes precedence over the backoff. Requests
+// asking for a delay longer than MaxRetryAfter are not retried.
+func (r *Helper) WithRetry(attempts int, backoff time.Duration) *Helper {
+	r.attempts = attempts
+	r.backoff = backoff
+	return r
+}
+
+// WithProxy routes all requests through the given proxy instead of the
+// proxy configured by the HTTP_PROXY/HTTPS_PROXY environment variables.
+// It must be applied before the client transport is wrapped. A nil proxy
+// keeps the environment configuration.
+func (r *Helper) WithProxy(proxy *url.URL) *Helper {
+	if proxy == nil {
+		return r
+	}
+	if rt, ok := r.Client.Transport.(*roundTripper); ok {
+		if t, ok := rt.base.(*http.Transport); ok {
+			t.Proxy = http.ProxyURL(proxy)
+		}
+	}
+	return r
+}
+
+// WithLimiter limits the number of concurrent requests. Limiters can be shared
+// between helpers. It must be applied before the client transport is wrapped
+// to limit actual network requests. A nil limiter keeps requests unlimited.
+func (r *Helper) WithLimiter(l *Limiter) *Helper {
+	if l == nil {
+		return r
+	}
+	r.Client.Transport = &limitTransport{
+		limiter: l,
+		base:    r.Client.Transport,
+	}
+	return r
+}
+
+// WithHeaders adds the given headers to all requests.
+// Headers set by the wrapped transport, e.g. authorization, take precedence.
+func (r *Helper) WithHeaders(headers map[string]string) *Helper {
+	if len(headers) == 0 {
+		return r
+	}
+	r.Client.Transport = &transport.Decorator{
+		Decorator: transport.DecorateHeaders(headers),
+		Base:      r.Client.Transport,
+	}
+	return r
+}
+
+// WithUserAgent sets the user agent of all requests not specifying one.
+// An empty user agent keeps the Go default.
+func (r *Helper) WithUserAgent(ua string) *Helper {
+	if ua == "" {
+		return r
+	}
+	r.Client.Transport = &transport.Decorator{
+		Decorator: func(req *http.Request) error {
+			if req.Header.Get("User-Agent") == "" {
+				req.Header.Set("User-Agent", ua)
+			}
+			return nil
+		},
+		Base: r.Client.Transport,
+	}
+	return r
+}
+
+// WithCapture enables capturing the last decoded JSON response body for debugging.
+// Values of keys indicating credentials like tokens and of keys containing any of
+// the given key parts are redacted.
+func (r *Helper) WithCapture(redact ...string) *Helper {
+	r.capture = &capture{keys: redact}
+	return r
+}
+
+// LastJSON returns the last decoded JSON response body if capturing is enabled
+func (r *Helper) LastJSON() []byte {
+	if r.capture == nil {
+		return nil
+	}
+
+	r.capture.mu.Lock()
+	defer r.capture.mu.Unlock()
+
+	return r.capture.body
+}
+
+// mustRetry checks if the request can safely be retried after receiving the response
+func mustRetry(req *http.Request, resp *http.Response) bool {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		return false
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryAfter parses the Retry-After header given as seconds or http date
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	val := resp.Header.Get("Retry-After")
+	if val == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(val); err == nil {
+		return time.Duration(secs) * time.Second, true
+	}
+
+	if ts, err := http.ParseTime(val); err == nil {
+		return time.Until(ts), true
 	}
+
+	return 0, false
+}
+
+// do executes HTTP request, retrying idempotent requests on transient errors if enabled
+func (r *Helper) do(req *http.Request) (*http.Response, error) {
+	resp, err := r.Do(req)
+
+	backoff := r.backoff
+	for attempt := 1; attempt < r.attempts && err == nil && mustRetry(req, resp); attempt++ {
+		delay := backoff
+		if d, ok := retryAfter(resp); ok {
+			delay = d
+		}
+		backoff *= 2
+
+		// don't block the caller, return the response instead
+		if delay > MaxRetryAfter {
+			break
+		}
+
+		resp.Body.Close()
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(delay):
+		}
+
+		resp, err = r.Do(req)
+	}
+
+	if err == nil {
+		limitResponse(resp, r.maxSize)
+	}
+
+	return resp, err
+}
+
+// DoResponse executes HTTP request applying the helper's retry and response size limits.
+// The caller must close the response body.
+func (r *Helper) DoResponse(req *http.Request) (*http.Response, error) {
+	return r.do(req)
 }
 
 // DoBody executes HTTP request and returns the response body
 func (r *Helper) DoBody(req *http.Request) ([]byte, error) {
-	resp, err := r.Do(req)
+	resp, err := r.do(req)
 	var body []byte
 	if err == nil {
 		body, err = ReadBody(resp)
@@ -47,13 +243,27 @@ func (r *Helper) GetBody(url string) ([]byte, error) {
 	resp, err := r.Get(url)
 	var body []byte
 	if err == nil {
+		limitResponse(resp, r.maxSize)
 		body, err = ReadBody(resp)
 	}
 	return body, err
 }
 
 // decodeJSON reads HTTP response and decodes JSON body if error is nil
-func decodeJSON(resp *http.Response, res interface{}) error {
+func (r *Helper) decodeJSON(resp *http.Response, res interface{}) error {
+	if r.capture != nil {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+
+		r.capture.mu.Lock()
+		r.capture.body = redactJSON(body, r.capture.keys...)
+		r.capture.mu.Unlock()
+
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
 	if err := ResponseError(resp); err != nil {
 		_ = json.NewDecoder(resp.Body).Decode(&res)
 		return err
@@ -65,10 +275,16 @@ func decodeJSON(resp *http.Response, res interface{}) error {
 // DoJSON executes HTTP request and decodes JSON response.
 // It returns a StatusError on response codes other than HTTP 2xx.
 func (r *Helper) DoJSON(req *http.Request, res interface{}) error {
-	resp, err := r.Do(req)
+	return r.DoJSONContext(req.Context(), req, res)
+}
+
+// DoJSONContext executes HTTP request using the given context and decodes JSON response.
+// It returns a StatusError on response codes other than HTTP 2xx.
+func (r *Helper) DoJSONContext(ctx context.Context, req *http.Request, res interface{}) error {
+	resp, err := r.do(req.WithContext(ctx))
 	if err == nil {
 		defer resp.Body.Close()
-		err = decodeJSON(resp, &res)
+		err = r.decodeJSON(resp, &res)
 	}
 	return err
 }
@@ -76,9 +292,48 @@ func (r *Helper) DoJSON(req *http.Request, res interface{}) error {
 // GetJSON executes HTTP GET request and decodes JSON response.
 // It returns a StatusError on response codes other than HTTP 2xx.
 func (r *Helper) GetJSON(url string, res interface{}) error {
+	return r.GetJSONContext(context.Background(), url, res)
+}
+
+// ErrNotModified is returned by conditional requests if the resource has not changed
+var ErrNotModified = errors.New("not modified")
+
+// GetJSONConditional executes a conditional HTTP GET request using the given context and etag and decodes JSON response.
+// It returns the response's etag. If the resource has not changed, ErrNotModified is returned and res is not modified.
+// It returns a StatusError on response codes other than HTTP 2xx.
+func (r *Helper) GetJSONConditional(ctx context.Context, url, etag string, res interface{}) (string, error) {
+	req, err := New(http.MethodGet, url, nil, AcceptJSON)
+	if err != nil {
+		return etag, err
+	}
+
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+
+	resp, err := r.do(req.WithContext(ctx))
+	if err != nil {
+		return etag, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified {
+		return etag, ErrNotModified
+	}
+
+	if err := r.decodeJSON(resp, &res); err != nil {
+		return etag, err
+	}
+
+	return resp.Header.Get("ETag"), nil
+}
+
+// GetJSONContext executes HTTP GET request using the given context and decodes JSON response.
+// It returns a StatusError on response codes other than HTTP 2xx.
+func (r *Helper) GetJSONContext(ctx context.Context, url string, res interface{}) error {
 	req, err := New(http.MethodGet, url, nil, AcceptJSON)
 	if err == nil {
-		err = r.DoJSON(req, &res)
+		err = r.DoJSONContext(ctx, req, &res)
 	}
 	return err
 }
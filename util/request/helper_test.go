@@ -0,0 +1,172 @@
+package request
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHelperProxy(t *testing.T) {
+	var proxied string
+
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// proxy requests carry the absolute target uri
+		proxied = r.URL.String()
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer proxy.Close()
+
+	u, err := url.Parse(proxy.URL)
+	require.NoError(t, err)
+
+	helper := NewHelper(util.NewLogger("foo")).WithProxy(u)
+
+	var res struct{}
+	require.NoError(t, helper.GetJSON("http://vehicle.invalid/v1/bulk", &res))
+	assert.Equal(t, "http://vehicle.invalid/v1/bulk", proxied)
+}
+
+func TestGetJSONConditional(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == "foo" {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", "foo")
+		_, _ = w.Write([]byte(`{"a":1}`))
+	}))
+	defer srv.Close()
+
+	helper := NewHelper(util.NewLogger("foo"))
+
+	var res struct{ A int }
+	etag, err := helper.GetJSONConditional(context.Background(), srv.URL, "", &res)
+	require.NoError(t, err)
+	assert.Equal(t, "foo", etag)
+	assert.Equal(t, 1, res.A)
+
+	res.A = 0
+	etag, err = helper.GetJSONConditional(context.Background(), srv.URL, etag, &res)
+	assert.ErrorIs(t, err, ErrNotModified)
+	assert.Equal(t, "foo", etag)
+	assert.Equal(t, 0, res.A)
+}
+
+func TestHelperCapture(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"level":50,"auth":{"access_token":"secret"}}`))
+	}))
+	defer srv.Close()
+
+	h := NewHelper(util.NewLogger("foo"))
+
+	var res map[string]any
+	require.NoError(t, h.GetJSON(srv.URL, &res))
+	assert.Nil(t, h.LastJSON())
+
+	h.WithCapture()
+
+	require.NoError(t, h.GetJSON(srv.URL, &res))
+	assert.Equal(t, 50.0, res["level"])
+	assert.JSONEq(t, `{"level":50,"auth":{"access_token":"***"}}`, string(h.LastJSON()))
+}
+
+func TestHelperHeaders(t *testing.T) {
+	var header http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+	}))
+	defer srv.Close()
+
+	h := NewHelper(util.NewLogger("foo")).WithHeaders(map[string]string{"X-Region": "eu"})
+
+	_, err := h.GetBody(srv.URL)
+	require.NoError(t, err)
+	assert.Equal(t, "eu", header.Get("X-Region"))
+}
+
+func TestHelperUserAgent(t *testing.T) {
+	var header http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+	}))
+	defer srv.Close()
+
+	h := NewHelper(util.NewLogger("foo")).WithUserAgent("evcc/test")
+
+	_, err := h.GetBody(srv.URL)
+	require.NoError(t, err)
+	assert.Equal(t, "evcc/test", header.Get("User-Agent"))
+
+	// explicit headers take precedence
+	h = NewHelper(util.NewLogger("foo")).WithHeaders(map[string]string{"User-Agent": "custom"}).WithUserAgent("evcc/test")
+
+	_, err = h.GetBody(srv.URL)
+	require.NoError(t, err)
+	assert.Equal(t, "custom", header.Get("User-Agent"))
+}
+
+func TestHelperMaxResponseSize(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":"` + strings.Repeat("x", 1024) + `"}`))
+	}))
+	defer srv.Close()
+
+	var res struct{ Data string }
+
+	// oversized response
+	helper := NewHelper(util.NewLogger("foo")).WithMaxResponseSize(1024)
+
+	err := helper.GetJSON(srv.URL, &res)
+	assert.True(t, errors.Is(err, ErrResponseTooLarge), err)
+
+	_, err = helper.GetBody(srv.URL)
+	assert.True(t, errors.Is(err, ErrResponseTooLarge), err)
+
+	req, _ := New(http.MethodPost, srv.URL, nil)
+	resp, err := helper.DoResponse(req)
+	require.NoError(t, err)
+	_, err = ReadBody(resp)
+	assert.True(t, errors.Is(err, ErrResponseTooLarge), err)
+
+	// response within limit
+	helper = NewHelper(util.NewLogger("foo"))
+	require.NoError(t, helper.GetJSON(srv.URL, &res))
+	assert.Len(t, res.Data, 1024)
+
+	b, err := helper.GetBody(srv.URL)
+	require.NoError(t, err)
+	assert.Len(t, b, 1024+len(`{"data":""}`))
+}
+
+func TestHelperRetryAfterLimit(t *testing.T) {
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	helper := NewHelper(util.NewLogger("foo")).WithRetry(3, time.Millisecond)
+
+	// excessive delay is not waited for
+	var res struct{}
+	start := time.Now()
+	err := helper.GetJSON(srv.URL, &res)
+
+	var se StatusError
+	require.ErrorAs(t, err, &se)
+	assert.True(t, se.HasStatus(http.StatusTooManyRequests))
+	assert.Equal(t, 1, calls)
+	assert.Less(t, time.Since(start), time.Second)
+}
@@ -0,0 +1,101 @@
+package request
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// Limiter limits the number of concurrent in-flight requests. A nil Limiter is unlimited.
+type Limiter struct {
+	sem chan struct{}
+}
+
+// NewLimiter creates a limiter allowing up to n concurrent requests. It returns nil if n is not positive.
+func NewLimiter(n int) *Limiter {
+	if n <= 0 {
+		return nil
+	}
+	return &Limiter{sem: make(chan struct{}, n)}
+}
+
+var (
+	limitersMu sync.Mutex
+	limiters   = make(map[string]*Limiter)
+)
+
+// SharedLimiter returns the limiter shared by all callers using the same key, e.g. a credential.
+// The limit of the first caller applies.
+func SharedLimiter(key string, n int) *Limiter {
+	if n <= 0 {
+		return nil
+	}
+
+	limitersMu.Lock()
+	defer limitersMu.Unlock()
+
+	l, ok := limiters[key]
+	if !ok {
+		l = NewLimiter(n)
+		limiters[key] = l
+	}
+
+	return l
+}
+
+// Acquire blocks until a request slot is available or the context is cancelled
+func (l *Limiter) Acquire(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	select {
+	case l.sem <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Release frees a request slot
+func (l *Limiter) Release() {
+	if l != nil {
+		<-l.sem
+	}
+}
+
+// limitTransport holds a request slot until the response body is closed
+type limitTransport struct {
+	limiter *Limiter
+	base    http.RoundTripper
+}
+
+func (t *limitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Acquire(req.Context()); err != nil {
+		return nil, err
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		t.limiter.Release()
+		return nil, err
+	}
+
+	resp.Body = &releaseBody{ReadCloser: resp.Body, release: t.limiter.Release}
+
+	return resp, nil
+}
+
+// releaseBody releases the request slot once on close
+type releaseBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releaseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
@@ -0,0 +1,53 @@
+package request
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimiter(t *testing.T) {
+	const limit = 2
+
+	var current, peak atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := current.Add(1)
+		defer current.Add(-1)
+
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+
+		time.Sleep(10 * time.Millisecond)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	// helpers sharing the limiter, e.g. multiple vehicles of one account
+	l := SharedLimiter(t.Name(), limit)
+	assert.Same(t, l, SharedLimiter(t.Name(), 10*limit))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		h := NewHelper(util.NewLogger("foo")).WithLimiter(l)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var res struct{}
+			assert.NoError(t, h.GetJSON(srv.URL, &res))
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(limit), peak.Load())
+}
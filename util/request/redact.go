@@ -0,0 +1,60 @@
+package request
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/evcc-io/evcc/util"
+)
+
+// redactKeys are parts of JSON keys whose values are redacted
+var redactKeys = []string{"token", "secret", "password"}
+
+// redactJSON replaces values of credential keys and the additional keys. Invalid JSON is returned as is.
+func redactJSON(body []byte, keys ...string) []byte {
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return body
+	}
+
+	b, err := json.Marshal(redactValue(v, keys))
+	if err != nil {
+		return body
+	}
+
+	return b
+}
+
+func redactValue(v any, keys []string) any {
+	switch t := v.(type) {
+	case map[string]any:
+		for k, val := range t {
+			if redactKey(k, keys) {
+				t[k] = util.RedactReplacement
+			} else {
+				t[k] = redactValue(val, keys)
+			}
+		}
+	case []any:
+		for i, val := range t {
+			t[i] = redactValue(val, keys)
+		}
+	}
+
+	return v
+}
+
+func redactKey(key string, keys []string) bool {
+	key = strings.ToLower(key)
+	for _, k := range redactKeys {
+		if strings.Contains(key, k) {
+			return true
+		}
+	}
+	for _, k := range keys {
+		if strings.Contains(key, strings.ToLower(k)) {
+			return true
+		}
+	}
+	return false
+}
@@ -21,8 +21,11 @@ type roundTripper struct {
 var (
 	LogHeaders bool
 	LogMaxLen  = 1024 * 8
+	Metrics    bool // record per client metrics
 	reqMetric  *prometheus.SummaryVec
 	resMetric  *prometheus.CounterVec
+	durMetric  *prometheus.HistogramVec
+	cntMetric  *prometheus.CounterVec
 )
 
 func init() {
@@ -47,7 +50,26 @@ func init() {
 		Help:      "Total count of HTTP requests",
 	}, append(labels, "status"))
 
-	prometheus.MustRegister(reqMetric, resMetric)
+	// per client metrics labeled by log area, e.g. the vehicle, and host.
+	// Paths are not used as label since they contain ids and would create unbounded series.
+	clientLabels := []string{"area", "host"}
+
+	durMetric = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "evcc",
+		Subsystem: "http_client",
+		Name:      "request_duration_seconds",
+		Help:      "A histogram of HTTP request durations by client",
+		Buckets:   prometheus.DefBuckets,
+	}, clientLabels)
+
+	cntMetric = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "evcc",
+		Subsystem: "http_client",
+		Name:      "requests_total",
+		Help:      "Total count of HTTP requests by client",
+	}, append(clientLabels, "status"))
+
+	prometheus.MustRegister(reqMetric, resMetric, durMetric, cntMetric)
 }
 
 // NewTripper creates a logging roundtrip handler
@@ -122,9 +144,15 @@ func (r *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
 	resp, err := r.base.RoundTrip(req)
 
 	reqMetric.WithLabelValues(req.URL.Hostname()).Observe(time.Since(startTime).Seconds())
+	if Metrics {
+		durMetric.WithLabelValues(r.log.Area(), req.URL.Hostname()).Observe(time.Since(startTime).Seconds())
+	}
 
 	if err == nil {
 		resMetric.WithLabelValues(req.URL.Hostname(), strconv.Itoa(resp.StatusCode)).Add(1)
+		if Metrics {
+			cntMetric.WithLabelValues(r.log.Area(), req.URL.Hostname(), strconv.Itoa(resp.StatusCode)).Add(1)
+		}
 
 		if LogHeaders {
 			if body, err := httputil.DumpResponse(resp, true); err == nil {
@@ -141,6 +169,9 @@ func (r *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
 		}
 	} else {
 		resMetric.WithLabelValues(req.URL.Hostname(), "999").Add(1)
+		if Metrics {
+			cntMetric.WithLabelValues(r.log.Area(), req.URL.Hostname(), "999").Add(1)
+		}
 	}
 
 	if bld.Len() > 0 {
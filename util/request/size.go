@@ -0,0 +1,44 @@
+package request
+
+import (
+	"errors"
+	"io"
+	"net/http"
+)
+
+// MaxResponseSize is the default maximum response body size used by the Helper
+var MaxResponseSize int64 = 16 << 20
+
+// ErrResponseTooLarge is returned when reading a response body exceeding the maximum response size
+var ErrResponseTooLarge = errors.New("response too large")
+
+// limitResponse limits reading the response body to max bytes. A non-positive max keeps the body unlimited.
+func limitResponse(resp *http.Response, max int64) {
+	if resp == nil || max <= 0 {
+		return
+	}
+
+	resp.Body = &sizeBody{
+		ReadCloser: resp.Body,
+		r:          io.LimitReader(resp.Body, max+1),
+		max:        max,
+	}
+}
+
+// sizeBody fails reading once more than max bytes have been received
+type sizeBody struct {
+	io.ReadCloser
+	r         io.Reader // limited to max+1 bytes to detect exceeding the limit
+	read, max int64
+}
+
+func (b *sizeBody) Read(p []byte) (int, error) {
+	n, err := b.r.Read(p)
+	b.read += int64(n)
+
+	if b.read > b.max {
+		return n - int(b.read-b.max), ErrResponseTooLarge
+	}
+
+	return n, err
+}
@@ -2,31 +2,88 @@ package sponsor
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/evcc-io/evcc/api/proto/pb"
+	"github.com/evcc-io/evcc/api/store"
 	"github.com/evcc-io/evcc/util"
 	"github.com/evcc-io/evcc/util/cloud"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+const (
+	// warnPeriod is the time before expiry when renewal warnings are issued
+	warnPeriod = 14 * 24 * time.Hour
+
+	// gracePeriod is the time after expiry when existing integrations keep running read-only
+	gracePeriod = 7 * 24 * time.Hour
+
+	// offlinePeriod is the time a cached authorization is accepted while the sponsor service is unavailable
+	offlinePeriod = 7 * 24 * time.Hour
+)
+
 var (
 	Subject, Token string
-	ExpiresAt      time.Time
+
+	// Cache persists the last successful authorization, nil disables caching
+	Cache store.Store
+
+	expiresAt time.Time
+	grace     bool
 )
 
 func IsAuthorized() bool {
 	return len(Subject) > 0
 }
 
-// check and set sponsorship token
-func ConfigureSponsorship(token string) error {
+// ExpiresAt returns the sponsor token's expiry time
+func ExpiresAt() time.Time {
+	return expiresAt
+}
+
+// IsGracePeriod returns true if the sponsor token has expired but is still accepted read-only
+func IsGracePeriod() bool {
+	return grace
+}
+
+// Warning returns a renewal message if the sponsor token is about to expire or has expired
+func Warning() string {
+	if !IsAuthorized() || expiresAt.IsZero() {
+		return ""
+	}
+
+	if grace {
+		return fmt.Sprintf("sponsor token expired, read-only until %s, please renew", expiresAt.Add(gracePeriod).Format(time.DateOnly))
+	}
+
+	if d := time.Until(expiresAt); d < warnPeriod {
+		return fmt.Sprintf("sponsor token expires in %d days, please renew", int(d.Hours()/24))
+	}
+
+	return ""
+}
+
+// authorization is the cached result of a successful authorization
+type authorization struct {
+	Token     string // token hash
+	Subject   string
+	ExpiresAt time.Time
+	Validated time.Time
+}
+
+// authorize validates the token with the sponsor service
+var authorize = func(token string) (*pb.AuthReply, error) {
 	host := util.Getenv("GRPC_URI", cloud.Host)
 	conn, err := cloud.Connection(host)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	client := pb.NewAuthClient(conn)
@@ -34,21 +91,105 @@ func ConfigureSponsorship(token string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	res, err := client.IsAuthorized(ctx, &pb.AuthRequest{Token: token})
+	return client.IsAuthorized(ctx, &pb.AuthRequest{Token: token})
+}
+
+// hash returns the token hash for caching
+func hash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// saveAuthorization caches the current authorization
+func saveAuthorization(token string) {
+	if Cache == nil {
+		return
+	}
+
+	_ = Cache.Save(authorization{
+		Token:     hash(token),
+		Subject:   Subject,
+		ExpiresAt: expiresAt,
+		Validated: time.Now(),
+	})
+}
+
+// cachedAuthorization returns the cached authorization if it belongs to the token and has not exceeded the offline period
+func cachedAuthorization(token string) (authorization, bool) {
+	var res authorization
+	if Cache == nil || Cache.Load(&res) != nil {
+		return res, false
+	}
+
+	return res, res.Token == hash(token) && time.Since(res.Validated) < offlinePeriod
+}
+
+// check and set sponsorship token
+func ConfigureSponsorship(token string) error {
+	res, err := authorize(token)
 	if err == nil && res.Authorized {
 		Subject = res.Subject
-		ExpiresAt = res.ExpiresAt.AsTime()
+		expiresAt = res.ExpiresAt.AsTime()
 		Token = token
+		saveAuthorization(token)
+	}
+
+	// recently expired token
+	if err == nil && !res.Authorized {
+		if sub, exp, ok := claims(token); ok && time.Since(exp) > 0 && time.Since(exp) < gracePeriod {
+			Subject = sub
+			expiresAt = exp
+			Token = token
+			grace = true
+		}
 	}
 
 	if err != nil {
-		if s, ok := status.FromError(err); ok && s.Code() != codes.Unknown {
-			Subject = "sponsorship unavailable"
-			err = nil
-		} else {
+		// sponsor service temporarily unavailable, fall back to last successful authorization
+		if s, ok := status.FromError(err); !ok || s.Code() != codes.Unknown {
+			if auth, ok := cachedAuthorization(token); ok {
+				util.NewLogger("sponsor").WARN.Printf("sponsor service unavailable, using authorization validated at %s: %v",
+					auth.Validated.Local().Format(time.DateTime), err)
+
+				Subject = auth.Subject
+				expiresAt = auth.ExpiresAt
+				Token = token
+				err = nil
+			}
+		}
+
+		if err != nil {
 			err = fmt.Errorf("sponsortoken: %w", err)
 		}
 	}
 
+	if msg := Warning(); msg != "" {
+		util.NewLogger("sponsor").WARN.Println(msg)
+	}
+
 	return err
 }
+
+// claims decodes subject and expiry from the token without validation
+func claims(token string) (string, time.Time, bool) {
+	segs := strings.Split(token, ".")
+	if len(segs) != 3 {
+		return "", time.Time{}, false
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(segs[1])
+	if err != nil {
+		return "", time.Time{}, false
+	}
+
+	var res struct {
+		Sub string `json:"sub"`
+		Exp int64  `json:"exp"`
+	}
+
+	if err := json.Unmarshal(b, &res); err != nil || res.Sub == "" || res.Exp == 0 {
+		return "", time.Time{}, false
+	}
+
+	return res.Sub, time.Unix(res.Exp, 0), true
+}
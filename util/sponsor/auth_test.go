@@ -0,0 +1,91 @@
+package sponsor
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/api/proto/pb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type memStore struct {
+	b []byte
+}
+
+func (s *memStore) Load(res any) error {
+	if s.b == nil {
+		return errors.New("not found")
+	}
+	return json.Unmarshal(s.b, res)
+}
+
+func (s *memStore) Save(val any) error {
+	b, err := json.Marshal(val)
+	s.b = b
+	return err
+}
+
+func TestSponsorOffline(t *testing.T) {
+	defer func(f func(string) (*pb.AuthReply, error)) {
+		authorize = f
+		Subject, Token, Cache = "", "", nil
+	}(authorize)
+
+	exp := time.Now().Add(30 * 24 * time.Hour)
+	var err error
+
+	authorize = func(string) (*pb.AuthReply, error) {
+		if err != nil {
+			return nil, err
+		}
+		return &pb.AuthReply{Authorized: true, Subject: "foo", ExpiresAt: timestamppb.New(exp)}, nil
+	}
+
+	unavailable := status.Error(codes.Unavailable, "offline")
+
+	// never authorized
+	Cache = new(memStore)
+	err = unavailable
+	require.Error(t, ConfigureSponsorship("token"))
+	assert.False(t, IsAuthorized())
+
+	// successful authorization is cached
+	err = nil
+	require.NoError(t, ConfigureSponsorship("token"))
+	assert.True(t, IsAuthorized())
+
+	// service outage uses cached authorization
+	Subject = ""
+	err = unavailable
+	require.NoError(t, ConfigureSponsorship("token"))
+	assert.Equal(t, "foo", Subject)
+	assert.Equal(t, exp.Unix(), ExpiresAt().Unix())
+
+	// cache is bound to the token
+	Subject = ""
+	require.Error(t, ConfigureSponsorship("other"))
+	assert.False(t, IsAuthorized())
+
+	// cache exceeded offline period
+	var auth authorization
+	require.NoError(t, Cache.Load(&auth))
+	auth.Validated = time.Now().Add(-offlinePeriod - time.Hour)
+	require.NoError(t, Cache.Save(auth))
+
+	require.Error(t, ConfigureSponsorship("token"))
+	assert.False(t, IsAuthorized())
+
+	// rejected tokens are not served from cache
+	err = nil
+	require.NoError(t, ConfigureSponsorship("token"))
+	Subject = ""
+	err = status.Error(codes.Unknown, "invalid token")
+	require.Error(t, ConfigureSponsorship("token"))
+	assert.False(t, IsAuthorized())
+}
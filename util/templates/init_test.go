@@ -0,0 +1,17 @@
+package templates
+
+import (
+	"io/fs"
+	"testing"
+
+	"github.com/evcc-io/evcc/templates/definition"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTronityTemplate(t *testing.T) {
+	b, err := fs.ReadFile(definition.YamlTemplates, "vehicle/tronity.yaml")
+	require.NoError(t, err)
+
+	_, err = FromBytes(b)
+	require.NoError(t, err)
+}
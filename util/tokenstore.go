@@ -0,0 +1,63 @@
+package util
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+// FileTokenStore loads and saves tokens as json file
+type FileTokenStore struct {
+	path string
+}
+
+// NewFileTokenStore creates a token store backed by the given file
+func NewFileTokenStore(path string) *FileTokenStore {
+	return &FileTokenStore{path: path}
+}
+
+// Load reads the token file into res
+func (s *FileTokenStore) Load(res any) error {
+	b, err := os.ReadFile(s.path)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, res)
+}
+
+// Save replaces the token file with val, readable by the owner only
+func (s *FileTokenStore) Save(val any) error {
+	b, err := json.MarshalIndent(val, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// write to temp file and rename to never leave a partially written token file
+	f, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), s.path)
+}
+
+// WorldReadable checks if the token file is readable by other users
+func (s *FileTokenStore) WorldReadable() (bool, error) {
+	fi, err := os.Stat(s.path)
+	if err != nil {
+		return false, err
+	}
+
+	return fi.Mode().Perm()&0o004 != 0, nil
+}
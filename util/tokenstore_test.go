@@ -0,0 +1,34 @@
+package util
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileTokenStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tokens.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"access_token":"access"}`), 0o644))
+
+	s := NewFileTokenStore(path)
+
+	ok, err := s.WorldReadable()
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	var res map[string]string
+	require.NoError(t, s.Load(&res))
+	assert.Equal(t, "access", res["access_token"])
+
+	// written back readable by owner only
+	require.NoError(t, s.Save(map[string]string{"access_token": "new"}))
+	require.NoError(t, s.Load(&res))
+	assert.Equal(t, "new", res["access_token"])
+
+	ok, err = s.WorldReadable()
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
@@ -0,0 +1,90 @@
+package vehicle
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const minutesPerDay = 24 * 60
+
+// TimeWindow is a daily time window in local time. Windows ending before they
+// start span midnight, e.g. 22:00-06:00. Days refer to the day the window starts.
+type TimeWindow struct {
+	Days     []Weekday // all days if empty
+	From, To ClockTime
+}
+
+// contains checks if the window contains the given time
+func (w TimeWindow) contains(t time.Time) bool {
+	t = t.In(time.Local)
+
+	now := ClockTime(t.Hour()*60 + t.Minute())
+	today := Weekday(t.Weekday())
+	yesterday := Weekday((t.Weekday() + 6) % 7)
+
+	switch {
+	case w.From < w.To:
+		return w.onDay(today) && now >= w.From && now < w.To
+	case w.From == w.To:
+		// full day
+		return w.onDay(today)
+	default:
+		// overnight
+		return w.onDay(today) && now >= w.From || w.onDay(yesterday) && now < w.To
+	}
+}
+
+// onDay checks if the window starts on the given day
+func (w TimeWindow) onDay(day Weekday) bool {
+	if len(w.Days) == 0 {
+		return true
+	}
+
+	for _, d := range w.Days {
+		if d == day {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ClockTime is the time of day in minutes after midnight
+type ClockTime int
+
+// UnmarshalText parses the time of day as hh:mm
+func (c *ClockTime) UnmarshalText(text []byte) error {
+	s := string(text)
+
+	if s == "24:00" {
+		*c = minutesPerDay
+		return nil
+	}
+
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return fmt.Errorf("invalid time of day: %s", s)
+	}
+
+	*c = ClockTime(t.Hour()*60 + t.Minute())
+
+	return nil
+}
+
+// Weekday is the day of the week
+type Weekday time.Weekday
+
+// UnmarshalText parses the weekday by its english name or abbreviation
+func (d *Weekday) UnmarshalText(text []byte) error {
+	s := strings.ToLower(string(text))
+
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		if name := strings.ToLower(wd.String()); s == name || s == name[:3] {
+			*d = Weekday(wd)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid weekday: %s", text)
+}
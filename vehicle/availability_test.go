@@ -0,0 +1,74 @@
+package vehicle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAvailableHours(t *testing.T) {
+	local := time.Local
+	time.Local = time.FixedZone("CET", 3600)
+	defer func() { time.Local = local }()
+
+	var v embed
+	require.NoError(t, util.DecodeOther(map[string]any{
+		"availableHours": []map[string]any{
+			{"from": "22:00", "to": "06:00"},
+			{"days": []string{"sat", "Sunday"}, "from": "10:00", "to": "16:00"},
+		},
+	}, &v))
+
+	// 2023-06-02 is a friday
+	at := func(day, hour, min int) time.Time {
+		return time.Date(2023, 6, day, hour, min, 0, 0, time.Local)
+	}
+
+	tc := []struct {
+		t   time.Time
+		res bool
+	}{
+		{at(2, 21, 59), false},
+		{at(2, 22, 0), true},
+		{at(2, 23, 59), true},
+		{at(3, 0, 0), true},
+		{at(3, 5, 59), true},
+		{at(3, 6, 0), false},
+		{at(2, 10, 0), false}, // weekend only
+		{at(3, 9, 59), false},
+		{at(3, 10, 0), true},
+		{at(4, 15, 59), true},
+		{at(4, 16, 0), false},
+		{at(2, 22, 30).UTC(), true}, // evaluated in local time
+	}
+
+	for _, tc := range tc {
+		assert.Equal(t, tc.res, v.Available(tc.t), tc.t)
+	}
+}
+
+func TestAvailableHoursDays(t *testing.T) {
+	// overnight window belongs to the day it starts
+	w := TimeWindow{Days: []Weekday{Weekday(time.Friday)}, From: 22 * 60, To: 6 * 60}
+
+	assert.True(t, w.contains(time.Date(2023, 6, 3, 5, 59, 0, 0, time.Local)))  // saturday morning
+	assert.False(t, w.contains(time.Date(2023, 6, 3, 22, 0, 0, 0, time.Local))) // saturday night
+	assert.False(t, w.contains(time.Date(2023, 6, 2, 5, 59, 0, 0, time.Local))) // friday morning
+	assert.True(t, w.contains(time.Date(2023, 6, 2, 22, 0, 0, 0, time.Local)))  // friday night
+
+	// always available without windows
+	assert.True(t, new(embed).Available(time.Date(2023, 6, 2, 0, 0, 0, 0, time.Local)))
+}
+
+func TestAvailableHoursInvalid(t *testing.T) {
+	var c ClockTime
+	assert.Error(t, c.UnmarshalText([]byte("25:00")))
+	assert.NoError(t, c.UnmarshalText([]byte("24:00")))
+	assert.Equal(t, ClockTime(minutesPerDay), c)
+
+	var d Weekday
+	assert.Error(t, d.UnmarshalText([]byte("foo")))
+}
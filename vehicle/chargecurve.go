@@ -0,0 +1,63 @@
+package vehicle
+
+import (
+	"math"
+	"time"
+)
+
+// CurvePoint is the maximum charge power in kW at the given soc
+type CurvePoint struct {
+	Soc, Power float64
+}
+
+// ChargeCurve is the vehicle's maximum charge power depending on soc.
+// Power between points is interpolated linearly.
+type ChargeCurve []CurvePoint
+
+// defaultChargeCurve is a typical charge curve as C-rate, i.e. power relative to battery capacity, tapering above 80%
+var defaultChargeCurve = ChargeCurve{{0, 1.5}, {50, 1.5}, {80, 0.8}, {90, 0.4}, {100, 0.1}}
+
+// at returns the interpolated power at the given soc
+func (c ChargeCurve) at(soc float64) float64 {
+	lower, upper := -1, -1
+
+	for i, p := range c {
+		if p.Soc <= soc && (lower < 0 || p.Soc > c[lower].Soc) {
+			lower = i
+		}
+		if p.Soc >= soc && (upper < 0 || p.Soc < c[upper].Soc) {
+			upper = i
+		}
+	}
+
+	switch {
+	case lower < 0 && upper < 0:
+		return 0
+	case lower < 0:
+		return c[upper].Power
+	case upper < 0 || c[lower].Soc == c[upper].Soc:
+		return c[lower].Power
+	}
+
+	l, u := c[lower], c[upper]
+	return l.Power + (u.Power-l.Power)*(soc-l.Soc)/(u.Soc-l.Soc)
+}
+
+// chargeDuration estimates the duration for charging a battery of given capacity in kWh
+// from soc to target with up to power in W, limited by the charge curve in W
+func chargeDuration(capacity, soc, target, power float64, curve func(float64) float64) time.Duration {
+	var hours float64
+
+	for ; soc < target; soc++ {
+		step := math.Min(1, target-soc)
+
+		p := math.Min(power, curve(soc+step/2))
+		if p <= 0 {
+			return 0
+		}
+
+		hours += step / 100 * capacity * 1e3 / p
+	}
+
+	return time.Duration(float64(time.Hour) * hours).Round(time.Second)
+}
@@ -0,0 +1,57 @@
+package vehicle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChargeCurve(t *testing.T) {
+	var v embed
+	require.NoError(t, util.DecodeOther(map[string]any{
+		"capacity": 60,
+		"chargeCurve": []map[string]any{
+			{"soc": 80, "power": 50},
+			{"soc": 0, "power": 100},
+			{"soc": 100, "power": 10},
+		},
+	}, &v))
+
+	// interpolated, points need not be sorted
+	assert.Equal(t, 100e3, v.ChargePower(0))
+	assert.Equal(t, 75e3, v.ChargePower(40))
+	assert.Equal(t, 30e3, v.ChargePower(90))
+	assert.Equal(t, 10e3, v.ChargePower(120))
+
+	assert.Equal(t, 75e3, v.defaultChargePower(40))
+
+	// unknown without configured curve
+	v.ChargeCurve_ = nil
+	assert.Equal(t, 0.0, v.ChargePower(20))
+
+	// default curve relative to capacity
+	assert.Equal(t, 90e3, v.defaultChargePower(20))
+	assert.Equal(t, 6e3, v.defaultChargePower(100))
+}
+
+func TestChargeDuration(t *testing.T) {
+	flat := func(float64) float64 { return 100e3 }
+
+	// linear without taper
+	assert.Equal(t, time.Hour, chargeDuration(50, 0, 100, 50e3, flat))
+	assert.Equal(t, 30*time.Minute, chargeDuration(50, 50, 100, 50e3, flat))
+
+	// taper above 80% slows charging
+	taper := ChargeCurve{{0, 50}, {80, 50}, {100, 5}}
+	curve := func(soc float64) float64 { return 1e3 * taper.at(soc) }
+
+	d := chargeDuration(50, 50, 100, 50e3, curve)
+	assert.Greater(t, d, 30*time.Minute)
+	assert.Equal(t, 18*time.Minute, chargeDuration(50, 50, 80, 50e3, curve))
+
+	// no power
+	assert.Equal(t, time.Duration(0), chargeDuration(50, 50, 100, 0, curve))
+}
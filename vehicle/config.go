@@ -12,8 +12,15 @@ import (
 const (
 	expiry   = 5 * time.Minute  // maximum response age before refresh
 	interval = 15 * time.Minute // refresh interval when charging
+
+	webhookCache = time.Hour // refresh interval when receiving webhook updates
 )
 
+// positionSetter is implemented by the embed struct
+type positionSetter interface {
+	setPosition(api.VehiclePosition)
+}
+
 type vehicleRegistry map[string]func(map[string]interface{}) (api.Vehicle, error)
 
 func (r vehicleRegistry) Add(name string, factory func(map[string]interface{}) (api.Vehicle, error)) {
@@ -63,6 +70,13 @@ func NewFromConfig(typ string, other map[string]interface{}) (v api.Vehicle, err
 		if v, err = factory(cc.Other); err != nil {
 			err = fmt.Errorf("cannot create vehicle '%s': %w", typ, err)
 		}
+
+		// provide vehicle position for presence detection
+		if ps, ok := v.(positionSetter); ok {
+			if vp, ok := v.(api.VehiclePosition); ok {
+				ps.setPosition(vp)
+			}
+		}
 	} else {
 		err = fmt.Errorf("invalid vehicle type: %s", typ)
 	}
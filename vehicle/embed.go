@@ -1,7 +1,10 @@
 package vehicle
 
 import (
+	"time"
+
 	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
 )
 
 type embed struct {
@@ -12,6 +15,16 @@ type embed struct {
 	Identifiers_ []string         `mapstructure:"identifiers"`
 	Features_    []api.Feature    `mapstructure:"features"`
 	OnIdentify   api.ActionConfig `mapstructure:"onIdentify"`
+	Home_        Home             `mapstructure:"home"`
+	Available_   []TimeWindow     `mapstructure:"availableHours"`
+	MinSoc_      int              `mapstructure:"minSoc"`
+	ChargeCurve_ ChargeCurve      `mapstructure:"chargeCurve"`
+	Preferred_   string           `mapstructure:"preferredLoadpoint"`
+	Nominal_     float64          `mapstructure:"nominalCapacity"`
+	Service_     float64          `mapstructure:"serviceInterval"`
+	RangeBuffer_ float64          `mapstructure:"rangeBuffer"`
+	position     api.VehiclePosition
+	name         string
 }
 
 // Title implements the api.Vehicle interface
@@ -29,6 +42,21 @@ func (v *embed) Capacity() float64 {
 	return v.Capacity_
 }
 
+var _ api.BatteryCapacities = (*embed)(nil)
+
+// UsableCapacity implements the api.BatteryCapacities interface
+func (v *embed) UsableCapacity() float64 {
+	return v.Capacity_
+}
+
+// NominalCapacity implements the api.BatteryCapacities interface
+func (v *embed) NominalCapacity() float64 {
+	if v.Nominal_ > 0 {
+		return v.Nominal_
+	}
+	return v.UsableCapacity()
+}
+
 // Phases returns the phases used by the vehicle
 func (v *embed) Phases() int {
 	return v.Phases_
@@ -44,6 +72,18 @@ func (v *embed) OnIdentified() api.ActionConfig {
 	return v.OnIdentify
 }
 
+var _ api.NameDescriber = (*embed)(nil)
+
+// Name implements the api.NameDescriber interface
+func (v *embed) Name() string {
+	return v.name
+}
+
+// SetName sets the configured name
+func (v *embed) SetName(name string) {
+	v.name = name
+}
+
 var _ api.IconDescriber = (*embed)(nil)
 
 // Icon implements the api.Vehicle interface
@@ -57,3 +97,98 @@ var _ api.FeatureDescriber = (*embed)(nil)
 func (v *embed) Features() []api.Feature {
 	return v.Features_
 }
+
+var _ api.VehicleMinSoc = (*embed)(nil)
+
+// MinSoc implements the api.VehicleMinSoc interface
+func (v *embed) MinSoc() int {
+	return v.MinSoc_
+}
+
+var _ api.VehiclePreferredLoadpoint = (*embed)(nil)
+
+// PreferredLoadpoint implements the api.VehiclePreferredLoadpoint interface
+func (v *embed) PreferredLoadpoint() string {
+	return v.Preferred_
+}
+
+var _ api.VehicleServiceInterval = (*embed)(nil)
+
+// ServiceInterval implements the api.VehicleServiceInterval interface
+func (v *embed) ServiceInterval() float64 {
+	return v.Service_
+}
+
+var _ api.VehicleRangeBuffer = (*embed)(nil)
+
+// RangeBuffer implements the api.VehicleRangeBuffer interface
+func (v *embed) RangeBuffer() float64 {
+	return v.RangeBuffer_
+}
+
+var _ api.VehicleChargeCurve = (*embed)(nil)
+
+// ChargePower implements the api.VehicleChargeCurve interface.
+// Without configured charge curve, charge power is unknown.
+func (v *embed) ChargePower(soc float64) float64 {
+	if len(v.ChargeCurve_) == 0 {
+		return 0
+	}
+
+	return 1e3 * v.ChargeCurve_.at(soc)
+}
+
+// defaultChargePower returns the configured charge curve's power or,
+// if not configured, the power of a default curve relative to the vehicle's capacity
+func (v *embed) defaultChargePower(soc float64) float64 {
+	if len(v.ChargeCurve_) > 0 {
+		return v.ChargePower(soc)
+	}
+
+	return 1e3 * v.Capacity() * defaultChargeCurve.at(soc)
+}
+
+var _ api.VehicleAvailability = (*embed)(nil)
+
+// Available implements the api.VehicleAvailability interface.
+// Vehicles without available hours are always available.
+func (v *embed) Available(t time.Time) bool {
+	if len(v.Available_) == 0 {
+		return true
+	}
+
+	for _, w := range v.Available_ {
+		if w.contains(t) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// setPosition provides the vehicle position for presence detection
+func (v *embed) setPosition(position api.VehiclePosition) {
+	v.position = position
+}
+
+var _ api.VehiclePresent = (*embed)(nil)
+
+// Present implements the api.VehiclePresent interface.
+// Presence is not available if either home or vehicle position are unknown.
+func (v *embed) Present() (bool, error) {
+	if v.Home_.Radius == 0 || v.position == nil {
+		return false, api.ErrNotAvailable
+	}
+
+	lat, lon, err := v.position.Position()
+	if err != nil {
+		return false, err
+	}
+
+	// vehicles without gps fix may report null island
+	if lat == 0 && lon == 0 {
+		return false, api.ErrNotAvailable
+	}
+
+	return util.Distance(lat, lon, v.Home_.Latitude, v.Home_.Longitude) <= v.Home_.Radius, nil
+}
@@ -0,0 +1,44 @@
+package vehicle
+
+import (
+	"sync"
+
+	"github.com/evcc-io/evcc/api"
+)
+
+// energyRater derives the session charged energy from a vehicle's cumulative energy counter
+type energyRater struct {
+	mu          sync.Mutex
+	status      api.ChargeStatus
+	start, last float64
+	valid       bool
+}
+
+// update records the vehicle's status and energy counter in kWh. The baseline is reset
+// when the vehicle gets connected or the counter has been reset.
+func (r *energyRater) update(status api.ChargeStatus, total float64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	connected := (r.status == api.StatusNone || r.status == api.StatusA) && (status == api.StatusB || status == api.StatusC)
+
+	if !r.valid || connected || total < r.start {
+		r.start = total
+		r.valid = true
+	}
+
+	r.status = status
+	r.last = total
+}
+
+// ChargedEnergy implements the api.ChargeRater interface
+func (r *energyRater) ChargedEnergy() (float64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.valid {
+		return 0, api.ErrNotAvailable
+	}
+
+	return r.last - r.start, nil
+}
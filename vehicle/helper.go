@@ -47,3 +47,45 @@ func ensureVehicleEx[Vehicle any](
 
 	return *new(Vehicle), err
 }
+
+// ensureVehiclePrefixEx extracts the single vehicle with VIN starting with the given prefix from list of vehicles.
+// An exact VIN match takes precedence, multiple prefix matches are an error.
+func ensureVehiclePrefixEx[Vehicle any](
+	prefix string,
+	list func() ([]Vehicle, error),
+	extract func(Vehicle) string,
+) (Vehicle, error) {
+	if prefix == "" {
+		return ensureVehicleEx(prefix, list, extract)
+	}
+
+	vehicles, err := list()
+	if err != nil {
+		return *new(Vehicle), fmt.Errorf("cannot get vehicles: %w", err)
+	}
+
+	prefix = strings.ToUpper(prefix)
+
+	matches := lo.Filter(vehicles, func(v Vehicle, _ int) bool {
+		return strings.HasPrefix(strings.ToUpper(extract(v)), prefix)
+	})
+
+	if vehicle, ok := lo.Find(matches, func(v Vehicle) bool {
+		return strings.ToUpper(extract(v)) == prefix
+	}); ok {
+		return vehicle, nil
+	}
+
+	switch len(matches) {
+	case 0:
+		err = fmt.Errorf("cannot find vehicle: %s", prefix)
+	case 1:
+		return matches[0], nil
+	default:
+		err = fmt.Errorf("ambiguous vin prefix: %s, got: %v", prefix, lo.Map(matches, func(v Vehicle, _ int) string {
+			return extract(v)
+		}))
+	}
+
+	return *new(Vehicle), err
+}
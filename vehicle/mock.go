@@ -0,0 +1,133 @@
+package vehicle
+
+import (
+	"sync"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+)
+
+// Mock is a simulated vehicle for reproducing charge planning behaviour without a real car.
+// Its soc rises at a fixed rate while charging has been started.
+type Mock struct {
+	*embed
+	mu       sync.Mutex
+	clock    clock.Clock
+	soc      float64
+	rate     float64 // %/h
+	maxRange float64 // km at 100% soc
+	charging bool
+	updated  time.Time
+}
+
+func init() {
+	registry.Add("mock", NewMockFromConfig)
+}
+
+// NewMockFromConfig creates a new vehicle
+func NewMockFromConfig(other map[string]interface{}) (api.Vehicle, error) {
+	cc := struct {
+		embed    `mapstructure:",squash"`
+		Soc      float64
+		Rate     float64
+		Range    float64
+		Charging bool
+	}{
+		Soc:   50,
+		Rate:  10,
+		Range: 400,
+	}
+
+	if err := util.DecodeOther(other, &cc); err != nil {
+		return nil, err
+	}
+
+	return NewMock(&cc.embed, clock.New(), cc.Soc, cc.Rate, cc.Range, cc.Charging), nil
+}
+
+// NewMock creates a new simulated vehicle
+func NewMock(embed *embed, clock clock.Clock, soc, rate, maxRange float64, charging bool) *Mock {
+	return &Mock{
+		embed:    embed,
+		clock:    clock,
+		soc:      soc,
+		rate:     rate,
+		maxRange: maxRange,
+		charging: charging,
+		updated:  clock.Now(),
+	}
+}
+
+// update advances the simulated soc. Must be called with lock held.
+func (v *Mock) update() {
+	now := v.clock.Now()
+
+	if v.charging {
+		v.soc += v.rate * now.Sub(v.updated).Hours()
+		if v.soc >= 100 {
+			v.soc = 100
+			v.charging = false
+		}
+	}
+
+	v.updated = now
+}
+
+// Soc implements the api.Vehicle interface
+func (v *Mock) Soc() (float64, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.update()
+	return v.soc, nil
+}
+
+var _ api.ChargeState = (*Mock)(nil)
+
+// Status implements the api.ChargeState interface
+func (v *Mock) Status() (api.ChargeStatus, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.update()
+	if v.charging {
+		return api.StatusC, nil
+	}
+
+	return api.StatusB, nil
+}
+
+var _ api.VehicleRange = (*Mock)(nil)
+
+// Range implements the api.VehicleRange interface
+func (v *Mock) Range() (int64, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.update()
+	return int64(v.soc * v.maxRange / 100), nil
+}
+
+var _ api.VehicleChargeController = (*Mock)(nil)
+
+// StartCharge implements the api.VehicleChargeController interface
+func (v *Mock) StartCharge() error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.update()
+	v.charging = v.soc < 100
+	return nil
+}
+
+// StopCharge implements the api.VehicleChargeController interface
+func (v *Mock) StopCharge() error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.update()
+	v.charging = false
+	return nil
+}
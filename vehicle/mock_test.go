@@ -0,0 +1,50 @@
+package vehicle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/benbjohnson/clock"
+	"github.com/evcc-io/evcc/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMock(t *testing.T) {
+	clock := clock.NewMock()
+	v := NewMock(new(embed), clock, 50, 10, 400, false)
+
+	status, _ := v.Status()
+	assert.Equal(t, api.StatusB, status)
+
+	// not charging
+	clock.Add(time.Hour)
+	soc, _ := v.Soc()
+	assert.Equal(t, 50.0, soc)
+
+	// charging
+	assert.NoError(t, v.StartCharge())
+	status, _ = v.Status()
+	assert.Equal(t, api.StatusC, status)
+
+	clock.Add(2 * time.Hour)
+	soc, _ = v.Soc()
+	assert.Equal(t, 70.0, soc)
+
+	rng, _ := v.Range()
+	assert.Equal(t, int64(280), rng)
+
+	// stopped
+	assert.NoError(t, v.StopCharge())
+	clock.Add(time.Hour)
+	soc, _ = v.Soc()
+	assert.Equal(t, 70.0, soc)
+
+	// full
+	assert.NoError(t, v.StartCharge())
+	clock.Add(10 * time.Hour)
+	soc, _ = v.Soc()
+	assert.Equal(t, 100.0, soc)
+
+	status, _ = v.Status()
+	assert.Equal(t, api.StatusB, status)
+}
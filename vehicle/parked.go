@@ -0,0 +1,119 @@
+package vehicle
+
+import (
+	"sync"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+)
+
+// parkedPolls is the number of consecutive unchanged updates after which the vehicle is considered parked
+const parkedPolls = 3
+
+// parkState is the vehicle state compared between updates
+type parkState struct {
+	status   api.ChargeStatus
+	soc      float64
+	odometer float64
+}
+
+// idle returns true if the vehicle is not charging. Driving is detected by changing soc or odometer.
+func (s parkState) idle() bool {
+	return s.status != api.StatusC
+}
+
+// parkDetector stretches the poll interval while the vehicle is parked, i.e. neither charging
+// nor driving with stable soc, to avoid keeping the vehicle awake and draining its 12V battery
+type parkDetector struct {
+	mu       sync.Mutex
+	interval time.Duration // poll interval while parked, disabled if zero
+	last     parkState
+	stable   int
+	resumeC  chan struct{}
+}
+
+func newParkDetector(interval time.Duration) *parkDetector {
+	return &parkDetector{
+		interval: interval,
+		resumeC:  make(chan struct{}, 1),
+	}
+}
+
+// update records the vehicle state. Any state change resumes normal polling.
+func (d *parkDetector) update(state parkState) {
+	// test guard
+	if d == nil {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if state == d.last && state.idle() {
+		d.stable++
+		return
+	}
+
+	wasParked := d.parked()
+	d.last = state
+	d.stable = 0
+
+	if wasParked {
+		d.signal()
+	}
+}
+
+// resume resumes normal polling, e.g. after a command has been sent to the vehicle
+func (d *parkDetector) resume() {
+	// test guard
+	if d == nil {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	wasParked := d.parked()
+	d.stable = 0
+
+	if wasParked {
+		d.signal()
+	}
+}
+
+// next returns the interval until the next poll
+func (d *parkDetector) next(interval time.Duration) time.Duration {
+	// test guard
+	if d == nil {
+		return interval
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.parked() && d.interval > interval {
+		return d.interval
+	}
+
+	return interval
+}
+
+// resumed returns the channel signalling that normal polling has been resumed
+func (d *parkDetector) resumed() <-chan struct{} {
+	// test guard
+	if d == nil {
+		return nil
+	}
+	return d.resumeC
+}
+
+func (d *parkDetector) parked() bool {
+	return d.interval > 0 && d.stable >= parkedPolls
+}
+
+func (d *parkDetector) signal() {
+	select {
+	case d.resumeC <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,62 @@
+package vehicle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParkDetector(t *testing.T) {
+	d := newParkDetector(time.Hour)
+
+	idle := parkState{status: api.StatusB, soc: 50, odometer: 1000}
+
+	// stretched after consecutive unchanged updates
+	d.update(idle)
+	for i := 0; i < parkedPolls; i++ {
+		assert.Equal(t, time.Minute, d.next(time.Minute), i)
+		d.update(idle)
+	}
+	assert.Equal(t, time.Hour, d.next(time.Minute))
+	assert.Empty(t, d.resumed())
+
+	// parked interval never shortens polling
+	assert.Equal(t, 2*time.Hour, d.next(2*time.Hour))
+
+	// driving resumes normal polling
+	d.update(parkState{status: api.StatusB, soc: 48, odometer: 1010})
+	assert.Equal(t, time.Minute, d.next(time.Minute))
+	assert.Len(t, d.resumed(), 1)
+	<-d.resumed()
+
+	// command resumes normal polling
+	for i := 0; i <= parkedPolls; i++ {
+		d.update(idle)
+	}
+	assert.Equal(t, time.Hour, d.next(time.Minute))
+
+	d.resume()
+	assert.Equal(t, time.Minute, d.next(time.Minute))
+	assert.Len(t, d.resumed(), 1)
+}
+
+func TestParkDetectorCharging(t *testing.T) {
+	d := newParkDetector(time.Hour)
+
+	// charging vehicle is never parked, even with stable soc
+	for i := 0; i <= 2*parkedPolls; i++ {
+		d.update(parkState{status: api.StatusC, soc: 50})
+	}
+	assert.Equal(t, time.Minute, d.next(time.Minute))
+}
+
+func TestParkDetectorDisabled(t *testing.T) {
+	d := newParkDetector(0)
+
+	for i := 0; i <= 2*parkedPolls; i++ {
+		d.update(parkState{status: api.StatusA, soc: 50})
+	}
+	assert.Equal(t, time.Minute, d.next(time.Minute))
+}
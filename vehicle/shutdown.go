@@ -0,0 +1,58 @@
+package vehicle
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// shutdownTimeout is the maximum time waiting for background routines to stop
+const shutdownTimeout = 5 * time.Second
+
+// background tracks vehicle background routines and cancels them on close
+type background struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newBackground() *background {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &background{ctx: ctx, cancel: cancel}
+}
+
+// Go runs fn in background until the context is cancelled
+func (b *background) Go(fn func(ctx context.Context)) {
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		fn(b.ctx)
+	}()
+}
+
+// Close cancels the context and waits for all background routines to return within timeout
+func (b *background) Close(timeout time.Duration) error {
+	b.cancel()
+
+	doneC := make(chan struct{})
+	go func() {
+		b.wg.Wait()
+		close(doneC)
+	}()
+
+	select {
+	case <-doneC:
+		return nil
+	case <-time.After(timeout):
+		return errors.New("timeout waiting for vehicles to stop")
+	}
+}
+
+// registryBackground is shared by all vehicles created from the registry
+var registryBackground = newBackground()
+
+// Close cancels pending requests and stops background polling of all vehicles
+func Close() error {
+	return registryBackground.Close(shutdownTimeout)
+}
@@ -0,0 +1,40 @@
+package vehicle
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackgroundClose(t *testing.T) {
+	b := newBackground()
+
+	var running atomic.Int32
+	for i := 0; i < 5; i++ {
+		running.Add(1)
+		b.Go(func(ctx context.Context) {
+			defer running.Add(-1)
+			<-ctx.Done()
+		})
+	}
+
+	assert.NoError(t, b.Close(time.Second))
+	assert.Equal(t, int32(0), running.Load())
+}
+
+func TestBackgroundCloseTimeout(t *testing.T) {
+	b := newBackground()
+
+	stopC := make(chan struct{})
+	defer close(stopC)
+
+	// routine ignoring cancellation
+	b.Go(func(ctx context.Context) {
+		<-stopC
+	})
+
+	assert.Error(t, b.Close(10*time.Millisecond))
+}
@@ -0,0 +1,44 @@
+package vehicle
+
+import (
+	"math"
+	"sync"
+)
+
+// maxSocJump is the maximum plausible soc change in % between two readings when not fast charging
+const maxSocJump = 20
+
+// socFilter smoothes jittery vehicle soc readings using an exponential moving average
+type socFilter struct {
+	mu        sync.Mutex
+	smoothing float64 // weight of previous value between 0 (disabled) and 1
+	raw, soc  float64
+	valid     bool
+}
+
+// update adds a new raw soc reading. Implausible jumps are clamped unless fast charging.
+func (f *socFilter) update(raw float64, fastCharging bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.raw = raw
+
+	if !f.valid {
+		f.soc = raw
+		f.valid = true
+		return
+	}
+
+	if !fastCharging {
+		raw = math.Max(math.Min(raw, f.soc+maxSocJump), f.soc-maxSocJump)
+	}
+
+	f.soc = f.smoothing*f.soc + (1-f.smoothing)*raw
+}
+
+// values returns the raw and smoothed soc
+func (f *socFilter) values() (float64, float64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.raw, f.soc
+}
@@ -19,12 +19,22 @@ package vehicle
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"math"
 	"net/http"
+	"net/url"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/provider"
+	"github.com/evcc-io/evcc/server/db/settings"
 	"github.com/evcc-io/evcc/util"
 	"github.com/evcc-io/evcc/util/oauth"
 	"github.com/evcc-io/evcc/util/request"
@@ -38,55 +48,487 @@ import (
 type Tronity struct {
 	*embed
 	*request.Helper
-	log   *util.Logger
-	oc    *oauth2.Config
-	vid   string
-	bulkG func() (tronity.Bulk, error)
+	log         *util.Logger
+	oc          *oauth2.Config
+	uri         string
+	vid         string
+	vin         string
+	bulkG       *provider.Bulk[tronity.Bulk]
+	rangeG      func() (int64, error)   // range sub-getter of the bulk payload
+	odometerG   func() (float64, error) // odometer sub-getter of the bulk payload
+	targetSocG  func() (float64, error) // charge limit sub-getter of the bulk payload
+	insideG     func() (float64, error) // inside temperature sub-getter of the bulk payload
+	outsideG    func() (float64, error) // outside temperature sub-getter of the bulk payload
+	states      tronity.StatusMapper
+	mu          sync.Mutex
+	unsupported map[string]bool // commands not supported by the vehicle
+	secret      string          // webhook secret
+	pushed      *tronity.Bulk   // data received by webhook
+	socF        *socFilter      // soc smoothing
+	energy      *energyRater    // session energy from vehicle counter
+	maxAge      time.Duration   // maximum age of vehicle data
+	stale       bool            // vehicle data is outdated
+	ctx         context.Context // cancels pending requests
+	scopes      []string        // scopes granted for the vehicle
+	wakeup      time.Duration   // maximum time waiting for the vehicle to wake up
+	updated     time.Time       // last successful update
+	updateErr   error           // most recent update error
+	lifetime    float64         // maximum lifetime energy seen
+	proxy       *url.URL        // explicit http proxy
+	ratedRange  bool            // report rated instead of current range
+	ts          oauth2.TokenSource
+	appTS       *oauth.TokenSource // app flow token source, nil for code flow
+	etag        string             // etag of last bulk response
+	last        tronity.Bulk       // last bulk response
+	headers     map[string]string  // additional request headers
+	confirm     time.Duration      // charge command confirmation window, disabled if zero
+	attempts    int                // status polls within the confirmation window
+	userAgent   string             // user agent of all requests
+	threshold   int                // consecutive failures until the vehicle is considered offline
+	noLocation  bool               // never read or retain the vehicle position
+	limiter     *request.Limiter   // concurrent requests limit shared per credential
+	scheduled   *tronity.Schedule  // schedule pushed by evcc, nil if not owned
+	bg          *background        // stops background polling on shutdown
+	usable      float64            // usable capacity reported by the vehicle
+	parked      *parkDetector      // stretches background polling while parked
+	parkedEvery time.Duration      // poll interval while parked
+	nominal     float64            // nominal capacity reported by the vehicle
+	cache       time.Duration      // cache duration while not charging
+	charging    time.Duration      // cache duration while charging, disabled if zero
+	refresh     time.Duration      // effective background poll interval
+	jobEvery    time.Duration      // status poll interval of asynchronous commands
+	jobTimeout  time.Duration      // maximum duration of asynchronous commands
+	retries     int                // attempts of reads failing with transient errors, disabled if zero
+	retryDelay  time.Duration      // initial backoff between retries
 }
 
 func init() {
 	registry.Add("tronity", NewTronityFromConfig)
 }
 
-// go:generate go run ../cmd/tools/decorate.go -f decorateTronity -b *Tronity -r api.Vehicle -t "api.ChargeState,Status,func() (api.ChargeStatus, error)" -t "api.VehicleOdometer,Odometer,func() (float64, error)" -t "api.VehicleChargeController,StartCharge,func() error" -t "api.VehicleChargeController,StopCharge,func() error"
+// go:generate go run ../cmd/tools/decorate.go -f decorateTronity -b *Tronity -r api.Vehicle -t "api.ChargeState,Status,func() (api.ChargeStatus, error)" -t "api.VehicleOdometer,Odometer,func() (float64, error)" -t "api.VehiclePosition,Position,func() (float64, float64, error)" -t "api.VehicleChargeController,StartCharge,func() error" -t "api.VehicleChargeController,StopCharge,func() error" -t "api.VehicleLock,Lock,func() error" -t "api.VehicleLock,Unlock,func() error" -t "api.VehicleLocked,Locked,func() (bool, error)" -t "api.VehicleCurrentController,MaxCurrent,func(float64) error" -t "api.VehicleClimateController,StartClimater,func() error" -t "api.VehicleClimateController,StopClimater,func() error"
+
+const (
+	// fastChargePower is the charge power in kW above which soc jumps are plausible
+	fastChargePower = 22
+
+	// capacityTolerance is the relative deviation above which configured and reported capacity mismatch
+	capacityTolerance = 0.1
+
+	// vinSuffixLength is the number of vin characters identifying the vehicle in the log
+	vinSuffixLength = 6
+
+	// offlineFailures is the default number of consecutive failures until the vehicle is considered offline
+	offlineFailures = 5
+
+	// parkedInterval is the default poll interval while the vehicle is parked
+	parkedInterval = time.Hour
+
+	// range reported by the vehicle
+	rangeCurrent = "current"
+	rangeRated   = "rated"
+
+	vinMatchExact  = "exact"
+	vinMatchPrefix = "prefix"
+
+	// all vehicles of the account
+	vehiclesAll = "all"
+
+	wakeupCommand       = "wakeup"
+	wakeupRetryInterval = 5 * time.Second
+
+	jobInterval = 2 * time.Second
+	jobTimeout  = time.Minute
+
+	chargeCommand   = "charge"
+	currentCommand  = "current"
+	scheduleCommand = "schedule"
+)
+
+type tronityConfig struct {
+	embed        `mapstructure:",squash"`
+	Credentials  ClientCredentials
+	Tokens       Tokens
+	TokenFile    string // json file with access and refresh token, written back on refresh
+	VIN          string
+	VinMatch     string // vin matching, exact (default) or prefix
+	Vehicles     string // all creates a vehicle for each vehicle of the account
+	URI          string
+	Env          string // known deployment, prod (default) or staging
+	Cache        time.Duration
+	Interval     time.Duration
+	SocSmoothing float64
+	MaxAge       time.Duration
+	Timeout      time.Duration
+	Proxy        string
+	Headers      map[string]string
+	UserAgent    string
+	Log          string
+	Range        string // range reported by the vehicle, current (default) or rated
+	StatusMap    map[string]string
+	Wakeup       struct {
+		Timeout time.Duration
+	}
+	Confirm struct {
+		Timeout  time.Duration // disabled if zero
+		Attempts int
+	}
+	Offline struct {
+		Failures int // consecutive failures, disabled if zero
+	}
+	Parked struct {
+		Interval time.Duration // poll interval while parked, disabled if zero
+	}
+	Charging struct {
+		Cache time.Duration // cache duration while charging, disabled if zero
+	}
+	Job struct {
+		Interval time.Duration // status poll interval of asynchronous commands
+		Timeout  time.Duration
+	}
+	Retry struct {
+		Attempts int // reads retried on transient errors, disabled if zero
+		Backoff  time.Duration
+	}
+	Webhook struct {
+		Secret string
+	}
+
+	DisableLocation bool // ignore position even if location scope is granted
+	MaxConcurrent   int  // concurrent requests per credential, unlimited if zero
+}
 
 // NewTronityFromConfig creates a new vehicle
 func NewTronityFromConfig(other map[string]interface{}) (api.Vehicle, error) {
-	cc := struct {
-		embed       `mapstructure:",squash"`
-		Credentials ClientCredentials
-		Tokens      Tokens
-		VIN         string
-		Cache       time.Duration
-	}{
-		Cache: interval,
+	v, cc, err := newTronity(other)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := util.DecodeOther(other, &cc); err != nil {
+	vehicles, err := v.vehicles()
+	if err != nil {
+		return nil, fmt.Errorf("cannot get vehicles: %w", err)
+	}
+
+	ensure := ensureVehicleEx[tronity.Vehicle]
+	if strings.EqualFold(cc.VinMatch, vinMatchPrefix) {
+		ensure = ensureVehiclePrefixEx[tronity.Vehicle]
+	}
+
+	vehicle, err := ensure(
+		cc.VIN, func() ([]tronity.Vehicle, error) {
+			return vehicles, nil
+		},
+		func(v tronity.Vehicle) string {
+			return v.VIN
+		},
+	)
+
+	if err != nil {
+		// help users find the vin to configure
+		for _, vehicle := range vehicles {
+			v.log.INFO.Printf("found vehicle: %s (vin: %s)", vehicle.DisplayName, vehicle.VIN)
+		}
+
 		return nil, err
 	}
 
-	if err := cc.Credentials.Error(); err != nil {
+	if err := requireScopes(vehicle); err != nil {
 		return nil, err
 	}
 
-	if !sponsor.IsAuthorized() {
-		return nil, api.ErrSponsorRequired
+	// tag log output with the vin if not configured
+	if cc.Log == "" && cc.VIN == "" {
+		v.withLogger(logArea("tronity", vehicle.VIN))
+	}
+
+	res := v.decorate(vehicle, cc.Cache)
+	v.poll(cc.Interval)
+
+	return res, nil
+}
+
+// DiscoverTronityVehicles returns all vehicles of the configured Tronity account
+func DiscoverTronityVehicles(other map[string]interface{}) ([]tronity.Vehicle, error) {
+	v, _, err := newTronity(other)
+	if err != nil {
+		return nil, err
 	}
 
-	// authenticated http client with logging injected to the tronity client
-	log := util.NewLogger("tronity").Redact(cc.Credentials.ID, cc.Credentials.Secret)
+	return v.vehicles()
+}
 
-	oc, err := tronity.OAuth2Config(cc.Credentials.ID, cc.Credentials.Secret)
+// NewTronityVehicles creates a vehicle for each vehicle of the Tronity account
+func NewTronityVehicles(other map[string]interface{}) ([]api.Vehicle, error) {
+	v, cc, err := newTronity(other)
 	if err != nil {
 		return nil, err
 	}
 
+	vehicles, err := v.vehicles()
+	if err != nil {
+		return nil, fmt.Errorf("cannot get vehicles: %w", err)
+	}
+
+	// configured log area is used as prefix for distinguishing the vehicles
+	prefix := cc.Log
+	if prefix == "" {
+		prefix = "tronity"
+	}
+
+	res := make([]api.Vehicle, 0, len(vehicles))
+	for _, vehicle := range vehicles {
+		if err := requireScopes(vehicle); err != nil {
+			return nil, err
+		}
+
+		embed := cc.embed
+		if embed.Title_ == "" {
+			embed.Title_ = vehicle.DisplayName
+		}
+
+		vv := v.clone(&embed)
+		vv.withLogger(logArea(prefix, vehicle.VIN))
+
+		decorated := vv.decorate(vehicle, cc.Cache)
+		vv.poll(cc.Interval)
+
+		// provide vehicle position for presence detection
+		if vp, ok := decorated.(api.VehiclePosition); ok {
+			embed.setPosition(vp)
+		}
+
+		res = append(res, decorated)
+	}
+
+	return res, nil
+}
+
+// requireScopes returns a config error if the scopes mandatory for the vehicle have not been granted
+func requireScopes(vehicle tronity.Vehicle) error {
+	if !slices.Contains(vehicle.Scopes, tronity.ReadBattery) {
+		return util.NewConfigError(fmt.Errorf("vehicle %s: missing %s scope required for soc", vehicle.VIN, tronity.ReadBattery))
+	}
+
+	return nil
+}
+
+// newTronity creates the authenticated Tronity account client
+func newTronity(other map[string]interface{}) (*Tronity, *tronityConfig, error) {
+	cc := tronityConfig{
+		Cache:     interval,
+		Timeout:   request.Timeout,
+		UserAgent: request.UserAgent,
+	}
+	cc.Wakeup.Timeout = time.Minute
+	cc.Confirm.Attempts = 5
+	cc.Parked.Interval = parkedInterval
+	cc.Offline.Failures = offlineFailures
+	cc.Job.Interval = jobInterval
+	cc.Job.Timeout = jobTimeout
+	cc.Retry.Backoff = time.Second
+
+	if err := util.DecodeOther(other, &cc); err != nil {
+		return nil, nil, err
+	}
+
+	// collect all configuration problems
+	var errs []error
+
+	if cc.SocSmoothing < 0 || cc.SocSmoothing >= 1 {
+		errs = append(errs, fmt.Errorf("invalid soc smoothing: %.2f", cc.SocSmoothing))
+	}
+
+	states, err := tronity.NewStatusMapper(cc.StatusMap)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	if cc.Timeout <= 0 {
+		errs = append(errs, fmt.Errorf("invalid timeout: %v", cc.Timeout))
+	}
+
+	env, err := tronity.ResolveEnvironment(cc.Env, cc.URI)
+	if err != nil {
+		errs = append(errs, err)
+	} else if u, err := url.Parse(env.URI); err != nil || u.Scheme != "https" || u.Host == "" {
+		errs = append(errs, fmt.Errorf("invalid uri: %s", env.URI))
+	}
+	cc.URI = env.URI
+
+	// explicit proxy overrides HTTP_PROXY/HTTPS_PROXY environment
+	var proxy *url.URL
+	if cc.Proxy != "" {
+		if proxy, err = url.Parse(cc.Proxy); err != nil || proxy.Host == "" {
+			errs = append(errs, fmt.Errorf("invalid proxy: %s", cc.Proxy))
+		}
+	}
+
+	if cc.Confirm.Timeout < 0 || cc.Confirm.Timeout > 0 && cc.Confirm.Attempts <= 0 {
+		errs = append(errs, fmt.Errorf("invalid confirm: %v/%d", cc.Confirm.Timeout, cc.Confirm.Attempts))
+	}
+
+	if cc.Offline.Failures < 0 {
+		errs = append(errs, fmt.Errorf("invalid offline failures: %d", cc.Offline.Failures))
+	}
+
+	if cc.MaxConcurrent < 0 {
+		errs = append(errs, fmt.Errorf("invalid max concurrent: %d", cc.MaxConcurrent))
+	}
+
+	if cc.Parked.Interval < 0 {
+		errs = append(errs, fmt.Errorf("invalid parked interval: %v", cc.Parked.Interval))
+	}
+
+	if cc.Charging.Cache < 0 {
+		errs = append(errs, fmt.Errorf("invalid charging cache: %v", cc.Charging.Cache))
+	}
+
+	if cc.Retry.Attempts < 0 || cc.Retry.Attempts > 0 && cc.Retry.Backoff <= 0 {
+		errs = append(errs, fmt.Errorf("invalid retry: %d/%v", cc.Retry.Attempts, cc.Retry.Backoff))
+	}
+
+	if cc.Job.Interval <= 0 || cc.Job.Timeout < cc.Job.Interval {
+		errs = append(errs, fmt.Errorf("invalid job: %v/%v", cc.Job.Interval, cc.Job.Timeout))
+	}
+
+	switch strings.ToLower(cc.Range) {
+	case "", rangeCurrent, rangeRated:
+	default:
+		errs = append(errs, fmt.Errorf("invalid range: %s", cc.Range))
+	}
+
+	switch strings.ToLower(cc.VinMatch) {
+	case "", vinMatchExact, vinMatchPrefix:
+	default:
+		errs = append(errs, fmt.Errorf("invalid vin match: %s", cc.VinMatch))
+	}
+
+	switch strings.ToLower(cc.Vehicles) {
+	case "":
+	case vehiclesAll:
+		if cc.VIN != "" {
+			errs = append(errs, errors.New("vin and all vehicles are mutually exclusive"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("invalid vehicles: %s", cc.Vehicles))
+	}
+
+	// tokens may be loaded from file, refreshed tokens are written back
+	var fileStore *util.FileTokenStore
+	if cc.TokenFile != "" {
+		fileStore = util.NewFileTokenStore(cc.TokenFile)
+
+		var token oauth2.Token
+		switch err := fileStore.Load(&token); {
+		case err != nil:
+			errs = append(errs, fmt.Errorf("token file: %w", err))
+		case cc.Tokens != (Tokens{}):
+			errs = append(errs, errors.New("tokens and token file are mutually exclusive"))
+		default:
+			cc.Tokens = Tokens{Access: token.AccessToken, Refresh: token.RefreshToken}
+		}
+	}
+
+	// authorization is managed by oauth
+	for k := range cc.Headers {
+		if strings.EqualFold(k, "Authorization") {
+			errs = append(errs, fmt.Errorf("invalid header: %s", k))
+		}
+	}
+
+	// app flow requires credentials, code flow requires tokens
+	if credErr, tokenErr := cc.Credentials.Error(), cc.Tokens.Error(); credErr != nil && tokenErr != nil {
+		errs = append(errs, fmt.Errorf("either credentials (app flow) or tokens (code flow) required: %w", credErr))
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return nil, nil, util.NewConfigError(err)
+	}
+
+	if !sponsor.IsAuthorized() {
+		return nil, nil, api.ErrSponsorRequired
+	}
+
+	// configured log area is used verbatim, otherwise derived from the vin
+	area := cc.Log
+	if area == "" {
+		area = logArea("tronity", cc.VIN)
+	}
+
+	log := util.NewLogger(area).Redact(cc.Credentials.ID, cc.Credentials.Secret)
+
+	// vehicles of the same account share the request limit
+	limiter := request.NewLimiter(cc.MaxConcurrent)
+	if cc.Credentials.ID != "" {
+		limiter = request.SharedLimiter("tronity-"+cc.Credentials.ID, cc.MaxConcurrent)
+	}
+
+	oc, err := tronity.OAuth2Config(cc.Credentials.ID, cc.Credentials.Secret, env.AuthURI)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	log.INFO.Printf("environment: %s (%s)", env.Name, env.URI)
+
 	v := &Tronity{
 		log:    log,
 		embed:  &cc.embed,
-		Helper: request.NewHelper(log),
 		oc:     oc,
+		uri:    cc.URI,
+		secret: cc.Webhook.Secret,
+		socF:   &socFilter{smoothing: cc.SocSmoothing},
+		energy: new(energyRater),
+		maxAge: cc.MaxAge,
+		ctx:    registryBackground.ctx,
+		wakeup: cc.Wakeup.Timeout,
+		states: states,
+		proxy:  proxy,
+
+		headers:    cc.Headers,
+		ratedRange: strings.EqualFold(cc.Range, rangeRated),
+		confirm:    cc.Confirm.Timeout,
+		attempts:   cc.Confirm.Attempts,
+		userAgent:  cc.UserAgent,
+		threshold:  cc.Offline.Failures,
+		noLocation: cc.DisableLocation,
+		limiter:    limiter,
+		bg:         registryBackground,
+
+		parkedEvery: cc.Parked.Interval,
+		charging:    cc.Charging.Cache,
+		jobEvery:    cc.Job.Interval,
+		jobTimeout:  cc.Job.Timeout,
+		retries:     cc.Retry.Attempts,
+		retryDelay:  cc.Retry.Backoff,
+	}
+
+	log.DEBUG.Printf("request timeout: %v", cc.Timeout)
+
+	// webhook updates replace regular polling
+	if v.secret != "" && cc.Cache < webhookCache {
+		cc.Cache = webhookCache
+	}
+
+	// cache defines how long data is considered fresh, interval how often it is refreshed in background.
+	// For reads to always be served from cache, interval must be shorter than cache.
+	switch {
+	case cc.Interval <= 0:
+	case v.secret != "":
+		log.WARN.Println("interval ignored: webhook updates replace polling")
+		cc.Interval = 0
+	case cc.Cache <= 0 || cc.Interval >= cc.Cache:
+		log.WARN.Printf("interval %v should be shorter than cache %v", cc.Interval, cc.Cache)
+	}
+
+	// persist tokens across restarts since refresh tokens may be rotated
+	store := settings.NewStore(tokenStoreKey(cc.Credentials.ID, cc.Tokens.Refresh))
+	if fileStore != nil {
+		store = fileStore
+
+		if ok, err := fileStore.WorldReadable(); err == nil && ok {
+			log.WARN.Printf("token file is world-readable: %s", cc.TokenFile)
+		}
 	}
 
 	var ts oauth2.TokenSource
@@ -95,53 +537,308 @@ func NewTronityFromConfig(other map[string]interface{}) (api.Vehicle, error) {
 	if err := cc.Tokens.Error(); err != nil {
 		// use app flow if we don't have tokens
 		ts = oauth.RefreshTokenSource(&oauth2.Token{}, v)
+		v.appTS = ts.(*oauth.TokenSource)
 	} else {
 		// use provided tokens generated by code flow
-		ctx := context.WithValue(context.Background(), oauth2.HTTPClient, request.NewClient(log))
-		ts = oc.TokenSource(ctx, &oauth2.Token{
+		token := &oauth2.Token{
 			AccessToken:  cc.Tokens.Access,
 			RefreshToken: cc.Tokens.Refresh,
 			Expiry:       time.Now(),
-		})
+		}
+
+		// prefer persisted token over configured token
+		var persisted oauth2.Token
+		if err := store.Load(&persisted); err == nil && persisted.RefreshToken != "" {
+			token = &persisted
+		}
+
+		ctx := context.WithValue(context.Background(), oauth2.HTTPClient, request.NewHelper(log).WithProxy(proxy).WithHeaders(cc.Headers).WithUserAgent(cc.UserAgent).Client)
+		ts = oc.TokenSource(ctx, token)
 	}
 
-	// replace client transport with authenticated transport
-	v.Client.Transport = &oauth2.Transport{
-		Source: ts,
-		Base:   v.Client.Transport,
+	ts = oauth.NewPersistingTokenSource(ts, func(token *oauth2.Token) error {
+		err := store.Save(token)
+		if err != nil {
+			log.ERROR.Printf("persist token: %v", err)
+		}
+		return err
+	})
+
+	// coalesce concurrent refreshes, a rotated refresh token would invalidate the other request
+	v.ts = oauth.NewSingleFlightTokenSource(ts)
+	v.Helper = v.newHelper(log, cc.Timeout)
+
+	return v, &cc, nil
+}
+
+// newHelper creates the authenticated http client logging to the given logger
+func (v *Tronity) newHelper(log *util.Logger, timeout time.Duration) *request.Helper {
+	// captured responses must not reveal the position if disabled
+	var redact []string
+	if v.noLocation {
+		redact = []string{"latitude", "longitude"}
 	}
 
-	vehicle, err := ensureVehicleEx(
-		cc.VIN, v.vehicles,
-		func(v tronity.Vehicle) string {
-			return v.VIN
-		},
-	)
+	helper := request.NewHelper(log).WithProxy(v.proxy).WithLimiter(v.limiter).WithRetry(v.retries, v.retryDelay).WithCapture(redact...)
+	helper.Client.Timeout = timeout
 
-	if err != nil {
-		return nil, err
+	// wrap proxy-aware client transport with authenticated transport
+	helper.Client.Transport = &oauth2.Transport{
+		Source: v.ts,
+		Base:   helper.Client.Transport,
 	}
 
+	// token may expire in flight, refresh and retry once
+	helper.Client.Transport = &oauth.RetryTransport{
+		Refresh: v.ForceRefresh,
+		Base:    helper.Client.Transport,
+	}
+
+	// authorization is added after custom headers, custom headers override the user agent
+	return helper.WithHeaders(v.headers).WithUserAgent(v.userAgent)
+}
+
+// withLogger tags all log output of the vehicle including http requests with the given log area
+func (v *Tronity) withLogger(area string) {
+	v.log = util.NewLogger(area).Redact(v.oc.ClientID, v.oc.ClientSecret)
+	v.Helper = v.newHelper(v.log, v.Client.Timeout)
+}
+
+// tokenStoreKey returns the settings key of the account's persisted token. Code flow has no client id,
+// tokens are identified by the configured refresh token instead. Vehicles of the same account share the key.
+func tokenStoreKey(id, refresh string) string {
+	if id == "" {
+		sum := sha256.Sum256([]byte(refresh))
+		id = hex.EncodeToString(sum[:8])
+	}
+
+	return "tronity." + id
+}
+
+// logArea returns the log area for the vehicle, tagged with the vin suffix if available
+func logArea(prefix, vin string) string {
+	if vin == "" {
+		return prefix
+	}
+
+	if len(vin) > vinSuffixLength {
+		vin = vin[len(vin)-vinSuffixLength:]
+	}
+
+	return prefix + "-" + strings.ToLower(vin)
+}
+
+// clone creates a vehicle sharing the account's authenticated client and settings
+func (v *Tronity) clone(embed *embed) *Tronity {
+	return &Tronity{
+		embed:  embed,
+		Helper: v.Helper,
+		log:    v.log,
+		oc:     v.oc,
+		uri:    v.uri,
+		secret: v.secret,
+		socF:   &socFilter{smoothing: v.socF.smoothing},
+		energy: new(energyRater),
+		maxAge: v.maxAge,
+		ctx:    v.ctx,
+		wakeup: v.wakeup,
+		states: v.states,
+		proxy:  v.proxy,
+		ts:     v.ts,
+		appTS:  v.appTS,
+
+		headers:    v.headers,
+		ratedRange: v.ratedRange,
+		confirm:    v.confirm,
+		attempts:   v.attempts,
+		userAgent:  v.userAgent,
+		threshold:  v.threshold,
+		noLocation: v.noLocation,
+		limiter:    v.limiter,
+		bg:         v.bg,
+
+		parkedEvery: v.parkedEvery,
+		charging:    v.charging,
+		jobEvery:    v.jobEvery,
+		jobTimeout:  v.jobTimeout,
+		retries:     v.retries,
+		retryDelay:  v.retryDelay,
+	}
+}
+
+// decorate binds the vehicle and adds the api interfaces supported by the vehicle's scopes
+func (v *Tronity) decorate(vehicle tronity.Vehicle, cache time.Duration) api.Vehicle {
 	v.vid = vehicle.ID
-	v.bulkG = provider.Cached(v.bulk, cc.Cache)
+	v.vin = vehicle.VIN
+	v.detectCapacity(vehicle)
+
+	// zero or negative cache duration disables caching
+	v.bulkG = provider.BulkCached(v.bulk, cache).WithJitter(0.1).WithThreshold(v.threshold)
+	v.rangeG = v.bulkG.Int(v.bulkRange)
+	v.odometerG = v.bulkG.Float(bulkOptional("odometer", func(res tronity.Bulk) *tronity.Number { return res.Odometer }))
+	v.targetSocG = v.bulkG.Float(bulkOptional("chargeLimit", func(res tronity.Bulk) *float64 { return res.ChargeLimit }))
+	v.insideG = v.bulkG.Float(bulkOptional("insideTemp", func(res tronity.Bulk) *float64 { return res.InsideTemp }))
+	v.outsideG = v.bulkG.Float(bulkOptional("outsideTemp", func(res tronity.Bulk) *float64 { return res.OutsideTemp }))
+	v.cache = cache
+	v.parked = newParkDetector(v.parkedEvery)
+	v.scopes = vehicle.Scopes
+
+	v.unsupported = make(map[string]bool)
+
+	// soc is mandatory, scope is verified on creation
+	v.hasScope(tronity.ReadBattery, "soc")
 
 	var status func() (api.ChargeStatus, error)
-	if slices.Contains(vehicle.Scopes, tronity.ReadCharge) {
+	if v.hasScope(tronity.ReadCharge, "charge status") {
 		status = v.status
 	}
 
 	var odometer func() (float64, error)
-	if slices.Contains(vehicle.Scopes, tronity.ReadOdometer) {
+	if v.hasScope(tronity.ReadOdometer, "odometer") {
 		odometer = v.odometer
 	}
 
+	var position func() (float64, float64, error)
+	if v.noLocation {
+		v.log.DEBUG.Println("position disabled")
+	} else if v.hasScope(tronity.ReadLocation, "position") {
+		position = v.position
+	}
+
+	// current control rejected with HTTP 405 is not sent again
 	var start, stop func() error
-	if slices.Contains(vehicle.Scopes, tronity.WriteChargeStartStop) {
+	var current func(float64) error
+	if v.hasScope(tronity.WriteChargeStartStop, "charge control") {
 		start = v.startCharge
 		stop = v.stopCharge
+		current = v.maxCurrent
+	}
+
+	// lock status is only reported with lock scope
+	var lock, unlock func() error
+	var locked func() (bool, error)
+	if v.hasScope(tronity.WriteLockUnlock, "lock") {
+		lock = v.lock
+		unlock = v.unlock
+		locked = v.locked
+	}
+
+	var startClimater, stopClimater func() error
+	if v.hasScope(tronity.WriteClimate, "climate control") {
+		startClimater = v.startClimater
+		stopClimater = v.stopClimater
+	}
+
+	return decorateTronity(v, status, odometer, position, start, stop, lock, unlock, locked, current, startClimater, stopClimater)
+}
+
+// SetName sets the configured name and labels the vehicle's cache metrics
+func (v *Tronity) SetName(name string) {
+	v.embed.SetName(name)
+	v.bulkG.WithName(name)
+}
+
+// Identifiers implements the api.Identifier interface
+func (v *Tronity) Identifiers() []string {
+	res := v.embed.Identifiers()
+	if v.vin != "" && !slices.Contains(res, v.vin) {
+		res = append(slices.Clone(res), v.vin)
+	}
+	return res
+}
+
+// detectCapacity uses the capacity reported by the vehicle unless configured
+func (v *Tronity) detectCapacity(vehicle tronity.Vehicle) {
+	if vehicle.Nominal != nil && *vehicle.Nominal > 0 {
+		v.nominal = *vehicle.Nominal
+	}
+
+	if vehicle.Capacity == nil || *vehicle.Capacity <= 0 {
+		return
+	}
+
+	reported := *vehicle.Capacity
+	v.usable = reported
+
+	if v.Capacity_ == 0 {
+		v.Capacity_ = reported
+		v.log.INFO.Printf("detected capacity: %.1fkWh", reported)
+		return
+	}
+
+	if math.Abs(v.Capacity_-reported) > capacityTolerance*reported {
+		v.log.WARN.Printf("configured capacity %.1fkWh differs from reported capacity %.1fkWh", v.Capacity_, reported)
+	}
+}
+
+var _ api.BatteryCapacities = (*Tronity)(nil)
+
+// UsableCapacity implements the api.BatteryCapacities interface.
+// The capacity reported by the vehicle takes precedence over the configured capacity.
+func (v *Tronity) UsableCapacity() float64 {
+	if v.usable > 0 {
+		return v.usable
+	}
+	return v.embed.UsableCapacity()
+}
+
+// NominalCapacity implements the api.BatteryCapacities interface
+func (v *Tronity) NominalCapacity() float64 {
+	if v.nominal > 0 {
+		return v.nominal
+	}
+	if v.Nominal_ > 0 {
+		return v.Nominal_
+	}
+	return v.UsableCapacity()
+}
+
+var _ api.VINIdentifier = (*Tronity)(nil)
+
+// VIN implements the api.VINIdentifier interface
+func (v *Tronity) VIN() (string, error) {
+	if v.vin == "" {
+		return "", api.ErrNotAvailable
+	}
+	return v.vin, nil
+}
+
+// hasScope checks if the scope has been granted and warns about the unavailable feature otherwise
+func (v *Tronity) hasScope(scope, feature string) bool {
+	if slices.Contains(v.scopes, scope) {
+		return true
+	}
+
+	v.log.WARN.Printf("%s disabled: missing %s scope", feature, scope)
+	return false
+}
+
+var _ api.TokenController = (*Tronity)(nil)
+
+// TokenInfo implements the api.TokenController interface
+func (v *Tronity) TokenInfo() (time.Time, []string, error) {
+	token, err := v.ts.Token()
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+
+	return token.Expiry, v.scopes, nil
+}
+
+// ForceRefresh implements the api.TokenController interface.
+// Forcing a refresh is only supported for the app flow.
+func (v *Tronity) ForceRefresh() error {
+	if v.appTS == nil {
+		return api.ErrNotAvailable
+	}
+
+	if _, err := v.appTS.Refresh(); err != nil {
+		return err
 	}
 
-	return decorateTronity(v, status, odometer, start, stop), nil
+	// persist refreshed token
+	_, err := v.ts.Token()
+	return err
 }
 
 // RefreshToken performs token refresh by logging in with app context
@@ -162,89 +859,960 @@ func (v *Tronity) RefreshToken(_ *oauth2.Token) (*oauth2.Token, error) {
 	}
 
 	var token oauth2.Token
-	err = request.NewHelper(v.log).DoJSON(req, &token)
+	err = request.NewHelper(v.log).WithProxy(v.proxy).WithHeaders(v.headers).WithUserAgent(v.userAgent).DoJSON(req, &token)
 
 	return &token, err
 }
 
 // vehicles implements the vehicles api
 func (v *Tronity) vehicles() ([]tronity.Vehicle, error) {
-	uri := fmt.Sprintf("%s/v1/vehicles", tronity.URI)
+	uri := fmt.Sprintf("%s/v1/vehicles", v.uri)
 
-	var res tronity.Vehicles
-	err := v.GetJSON(uri, &res)
-
-	return res.Data, err
+	return tronity.AllVehicles(uri, func(uri string, res *tronity.Vehicles) error {
+		return v.GetJSON(uri, res)
+	})
 }
 
-// bulk implements the bulk api
-func (v *Tronity) bulk() (tronity.Bulk, error) {
-	uri := fmt.Sprintf("%s/v1/vehicles/%s/bulk", tronity.URI, v.vid)
-
-	var res tronity.Bulk
-	err := v.GetJSON(uri, &res)
+// SetContext sets the context used for cancelling pending vehicle requests.
+// Requests are cancelled on shutdown regardless of the context.
+func (v *Tronity) SetContext(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		select {
+		case <-v.bg.ctx.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
 
-	return res, err
+	v.mu.Lock()
+	v.ctx = ctx
+	v.mu.Unlock()
 }
 
-// Soc implements the api.Vehicle interface
-func (v *Tronity) Soc() (float64, error) {
-	res, err := v.bulkG()
-	return res.Level, err
+// requestContext returns the context for vehicle requests
+func (v *Tronity) requestContext() context.Context {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.ctx
 }
 
-// status implements the api.ChargeState interface
-func (v *Tronity) status() (api.ChargeStatus, error) {
-	status := api.StatusA // disconnected
-	res, err := v.bulkG()
-
-	if err == nil {
-		if res.Charging == "Charging" {
-			status = api.StatusC
-		}
+// poll refreshes the vehicle data in background to prewarm the cache until the request or shutdown context is cancelled
+func (v *Tronity) poll(interval time.Duration) {
+	if interval <= 0 {
+		return
 	}
 
-	return status, err
-}
+	v.bg.Go(func(ctx context.Context) {
+		timer := time.NewTimer(interval)
+		defer timer.Stop()
 
-var _ api.VehicleRange = (*Tronity)(nil)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-v.requestContext().Done():
+				return
+			case <-v.parked.resumed():
+				v.log.DEBUG.Println("vehicle active, resuming polling")
+				if !timer.Stop() {
+					<-timer.C
+				}
+				timer.Reset(interval)
+			case <-timer.C:
+				v.bulkG.Reset()
+				res, err := v.bulkG.Get()
+				if err != nil && !errors.Is(err, api.ErrNotAvailable) {
+					v.log.DEBUG.Printf("refresh: %v", err)
+				}
 
-// Range implements the api.VehicleRange interface
-func (v *Tronity) Range() (int64, error) {
-	res, err := v.bulkG()
-	return int64(res.Range), err
-}
+				charging := err == nil && v.states.BulkStatus(res) == api.StatusC
+				adapted := v.adapt(interval, charging)
 
-// odometer implements the api.VehicleOdometer interface
-func (v *Tronity) odometer() (float64, error) {
-	res, err := v.bulkG()
-	return res.Odometer, err
-}
+				next := v.parked.next(adapted)
+				if next != adapted {
+					v.log.DEBUG.Printf("vehicle parked, next refresh in %v", next)
+				}
+
+				v.mu.Lock()
+				v.refresh = next
+				v.mu.Unlock()
+
+				timer.Reset(next)
+			}
+		}
+	})
+}
+
+// adapt shortens cache duration and poll interval while the vehicle is charging and restores them otherwise.
+// The ratio of interval and cache is kept so that reads are still served from cache.
+func (v *Tronity) adapt(interval time.Duration, charging bool) time.Duration {
+	if v.charging <= 0 || v.charging >= v.cache {
+		return interval
+	}
+
+	if !charging {
+		v.bulkG.SetCache(v.cache)
+		return interval
+	}
+
+	v.bulkG.SetCache(v.charging)
+	return time.Duration(float64(interval) * float64(v.charging) / float64(v.cache))
+}
+
+// bulk implements the bulk api
+func (v *Tronity) bulk() (tronity.Bulk, error) {
+	// use data received by webhook
+	v.mu.Lock()
+	pushed := v.pushed
+	v.pushed = nil
+	v.mu.Unlock()
+
+	var res tronity.Bulk
+	var err error
+
+	if pushed != nil {
+		res = *pushed
+	} else {
+		v.mu.Lock()
+		etag := v.etag
+		v.mu.Unlock()
+
+		// conditional request avoids transferring unchanged data
+		uri := fmt.Sprintf("%s/v1/vehicles/%s/bulk", v.uri, v.vid)
+		etag, err = v.GetJSONConditional(v.requestContext(), uri, etag, &res)
+
+		if errors.Is(err, request.ErrNotModified) {
+			v.mu.Lock()
+			res = v.last
+			v.updateErr = nil
+			v.updated = time.Now()
+			v.mu.Unlock()
+
+			v.parked.update(v.parkState(res))
+
+			return res, nil
+		}
+
+		err = quotaError(err)
+
+		if err == nil {
+			v.mu.Lock()
+			v.etag = etag
+			v.mu.Unlock()
+		}
+	}
+
+	// position is dropped before being retained
+	if v.noLocation {
+		res.Latitude, res.Longitude = 0, 0
+	}
+
+	v.mu.Lock()
+	v.updateErr = err
+	if err == nil {
+		v.updated = time.Now()
+		v.last = res
+	}
+	v.mu.Unlock()
 
-func (v *Tronity) post(uri string) error {
-	resp, err := v.Post(uri, "", nil)
 	if err == nil {
-		err = request.ResponseError(resp)
+		if res.Invalid("level") == nil {
+			v.socF.update(float64(res.Level), res.Power >= fastChargePower)
+		}
+
+		if res.Energy != nil && res.Invalid("energy") == nil {
+			v.energy.update(v.states.BulkStatus(res), *res.Energy)
+		}
+
+		v.parked.update(v.parkState(res))
+	}
+
+	return res, err
+}
+
+// parkState returns the vehicle state used for detecting a parked vehicle
+func (v *Tronity) parkState(res tronity.Bulk) parkState {
+	s := parkState{
+		status: v.states.BulkStatus(res),
+		soc:    float64(res.Level),
+	}
+
+	if res.Odometer != nil {
+		s.odometer = float64(*res.Odometer)
 	}
 
-	// ignore HTTP 405
+	return s
+}
+
+var _ api.WebhookProvider = (*Tronity)(nil)
+
+// WebhookHandler implements the api.WebhookProvider interface
+func (v *Tronity) WebhookHandler() http.HandlerFunc {
+	if v.secret == "" {
+		return nil
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(io.LimitReader(r.Body, 1<<20))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if !tronity.ValidSignature(v.secret, body, r.Header.Get(tronity.SignatureHeader)) {
+			http.Error(w, "invalid signature", http.StatusUnauthorized)
+			return
+		}
+
+		var res tronity.Event
+		if err := json.Unmarshal(body, &res); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if res.VehicleID == "" || res.VehicleID == v.vid {
+			v.mu.Lock()
+			v.pushed = &res.Bulk
+			v.mu.Unlock()
+
+			v.bulkG.Reset()
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// checkAge returns api.ErrNotAvailable if the vehicle has not reported within max age.
+// Staleness is logged once when it starts.
+func (v *Tronity) checkAge(res tronity.Bulk) error {
+	if v.maxAge == 0 || res.Timestamp == 0 {
+		return nil
+	}
+
+	age := time.Since(res.Updated())
+	stale := age > v.maxAge
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if stale && !v.stale {
+		v.log.WARN.Printf("vehicle data outdated: last update %v ago", age.Round(time.Minute))
+	}
+	v.stale = stale
+
+	if stale {
+		return api.ErrNotAvailable
+	}
+
+	return nil
+}
+
+// Soc implements the api.Vehicle interface
+func (v *Tronity) Soc() (float64, error) {
+	if !slices.Contains(v.scopes, tronity.ReadBattery) {
+		return 0, fmt.Errorf("missing %s scope", tronity.ReadBattery)
+	}
+
+	res, err := v.bulkG.Get()
+	if err == nil {
+		err = v.checkAge(res)
+	}
+	if err == nil {
+		err = res.Invalid("level")
+	}
 	if err != nil {
-		if err2, ok := err.(request.StatusError); ok && err2.HasStatus(http.StatusMethodNotAllowed) {
-			err = nil
+		return 0, err
+	}
+
+	_, soc := v.socF.values()
+	return soc, nil
+}
+
+var _ api.VehicleUpdated = (*Tronity)(nil)
+
+// Updated implements the api.VehicleUpdated interface
+func (v *Tronity) Updated() (time.Time, error) {
+	res, err := v.bulkG.Get()
+	if err == nil && res.Timestamp == 0 {
+		err = api.ErrNotAvailable
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return res.Updated(), nil
+}
+
+var _ api.HealthReporter = (*Tronity)(nil)
+
+// Health implements the api.HealthReporter interface.
+// If enabled, failures are only reported once the failure threshold has been reached.
+func (v *Tronity) Health() (time.Time, error) {
+	v.mu.Lock()
+	updated, err := v.updated, v.updateErr
+	v.mu.Unlock()
+
+	if fc, ok := v.bulkG.Cacheable.(provider.FailureCounter); ok && v.threshold > 0 && !fc.Unavailable() {
+		err = nil
+	}
+
+	return updated, err
+}
+
+var _ api.Diagnosis = (*Tronity)(nil)
+
+// Diagnose implements the api.Diagnosis interface
+func (v *Tronity) Diagnose() {
+	raw, soc := v.socF.values()
+
+	v.log.INFO.Printf("soc (raw): %.1f%%", raw)
+	v.log.INFO.Printf("soc (smoothed): %.1f%%", soc)
+	if fc, ok := v.bulkG.Cacheable.(provider.FailureCounter); ok {
+		v.log.INFO.Printf("consecutive failures: %d", fc.Failures())
+	}
+
+	v.mu.Lock()
+	refresh := v.refresh
+	v.mu.Unlock()
+
+	if refresh > 0 {
+		v.log.INFO.Printf("refresh interval: %v", refresh)
+	}
+}
+
+// status implements the api.ChargeState interface
+func (v *Tronity) status() (api.ChargeStatus, error) {
+	res, err := v.bulkG.Get()
+	if err == nil {
+		err = v.checkAge(res)
+	}
+	if err == nil {
+		err = res.Invalid("charging", "plugged")
+	}
+	if err != nil {
+		return api.StatusA, err
+	}
+
+	return v.states.BulkStatus(res), nil
+}
+
+var _ api.ChargeRater = (*Tronity)(nil)
+
+// ChargedEnergy implements the api.ChargeRater interface
+func (v *Tronity) ChargedEnergy() (float64, error) {
+	if _, err := v.bulkG.Get(); err != nil {
+		return 0, err
+	}
+
+	return v.energy.ChargedEnergy()
+}
+
+var _ api.ResponseCapturer = (*Tronity)(nil)
+
+// LastResponse implements the api.ResponseCapturer interface
+func (v *Tronity) LastResponse() []byte {
+	return v.LastJSON()
+}
+
+var _ api.VehicleTemperature = (*Tronity)(nil)
+
+// InsideTemp implements the api.VehicleTemperature interface
+func (v *Tronity) InsideTemp() (float64, error) {
+	return v.insideG()
+}
+
+// OutsideTemp implements the api.VehicleTemperature interface
+func (v *Tronity) OutsideTemp() (float64, error) {
+	return v.outsideG()
+}
+
+// bulkOptional maps an optional field of the bulk payload. Fields not reported are not available.
+func bulkOptional[T float64 | tronity.Number](field string, f func(tronity.Bulk) *T) func(tronity.Bulk) (float64, error) {
+	return func(res tronity.Bulk) (float64, error) {
+		if err := res.Invalid(field); err != nil {
+			return 0, err
+		}
+
+		val := f(res)
+		if val == nil {
+			return 0, api.ErrNotAvailable
 		}
+
+		return float64(*val), nil
+	}
+}
+
+var _ api.VehicleRange = (*Tronity)(nil)
+
+// Range implements the api.VehicleRange interface
+func (v *Tronity) Range() (int64, error) {
+	return v.rangeG()
+}
+
+// bulkRange maps the bulk payload to the range, preferring the rated range if configured
+func (v *Tronity) bulkRange(res tronity.Bulk) (int64, error) {
+	rng, field := res.Range, "range"
+	if v.ratedRange && res.RatedRange != nil {
+		rng, field = res.RatedRange, "ratedRange"
+	}
+
+	if err := res.Invalid(field); err != nil {
+		return 0, err
+	}
+
+	// missing range must not be mistaken for empty battery
+	if rng == nil {
+		return 0, api.ErrNotAvailable
+	}
+
+	return int64(*rng), nil
+}
+
+var _ api.VehicleRangeDetail = (*Tronity)(nil)
+
+// RangeDetail implements the api.VehicleRangeDetail interface
+func (v *Tronity) RangeDetail() (int64, int64, error) {
+	res, err := v.bulkG.Get()
+	if err == nil {
+		err = res.Invalid("range", "ratedRange")
+	}
+	if err != nil {
+		return 0, 0, err
 	}
 
+	if res.RatedRange == nil || res.Range == nil {
+		return 0, 0, api.ErrNotAvailable
+	}
+
+	return int64(*res.RatedRange), int64(*res.Range), nil
+}
+
+// odometer implements the api.VehicleOdometer interface
+func (v *Tronity) odometer() (float64, error) {
+	return v.odometerG()
+}
+
+var _ api.SocLimiter = (*Tronity)(nil)
+
+// TargetSoc implements the api.SocLimiter interface
+func (v *Tronity) TargetSoc() (float64, error) {
+	return v.targetSocG()
+}
+
+var _ api.VehicleChargeCurve = (*Tronity)(nil)
+
+// ChargePower implements the api.VehicleChargeCurve interface.
+// Without configured charge curve, the default curve is used.
+func (v *Tronity) ChargePower(soc float64) float64 {
+	return v.embed.defaultChargePower(soc)
+}
+
+var _ api.VehicleFinishTimer = (*Tronity)(nil)
+
+// FinishTime implements the api.VehicleFinishTimer interface
+func (v *Tronity) FinishTime() (time.Time, error) {
+	res, err := v.bulkG.Get()
+	if err == nil {
+		err = res.Invalid("charging", "plugged", "power", "level")
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if v.states.BulkStatus(res) != api.StatusC || res.Power <= 0 || v.UsableCapacity() <= 0 {
+		return time.Time{}, api.ErrNotAvailable
+	}
+
+	// charging to the vehicle's charge limit or 100% at current charge power, tapering according to the charge curve
+	target := 100.0
+	if limit := res.ChargeLimit; limit != nil && res.Invalid("chargeLimit") == nil && *limit > 0 && *limit < 100 {
+		target = *limit
+	}
+
+	duration := chargeDuration(v.UsableCapacity(), float64(res.Level), target, 1e3*res.Power, v.ChargePower)
+
+	return time.Now().Add(duration), nil
+}
+
+var _ api.ChargeTimer = (*Tronity)(nil)
+
+// ChargingTime implements the api.ChargeTimer interface
+func (v *Tronity) ChargingTime() (time.Duration, error) {
+	res, err := v.bulkG.Get()
+	if err == nil {
+		err = res.Invalid("charging", "plugged", "chargeStart")
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if v.states.BulkStatus(res) != api.StatusC {
+		return 0, nil
+	}
+
+	if res.ChargeStart == 0 {
+		return 0, api.ErrNotAvailable
+	}
+
+	// vehicle clock may be ahead of local time
+	d := time.Since(time.UnixMilli(res.ChargeStart))
+	if d < 0 {
+		d = 0
+	}
+
+	return d, nil
+}
+
+var _ api.VehicleEnergy = (*Tronity)(nil)
+
+// LifetimeEnergy implements the api.VehicleEnergy interface
+func (v *Tronity) LifetimeEnergy() (float64, error) {
+	res, err := v.bulkG.Get()
+	if err == nil {
+		err = res.Invalid("energy")
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if res.Energy == nil {
+		return 0, api.ErrNotAvailable
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	// lifetime energy must not decrease
+	if *res.Energy < v.lifetime {
+		v.log.WARN.Printf("lifetime energy decreased: %.1fkWh < %.1fkWh", *res.Energy, v.lifetime)
+		return v.lifetime, nil
+	}
+
+	v.lifetime = *res.Energy
+
+	return v.lifetime, nil
+}
+
+var _ api.VehicleCurrent = (*Tronity)(nil)
+
+// ChargeCurrent implements the api.VehicleCurrent interface
+func (v *Tronity) ChargeCurrent() (float64, error) {
+	res, err := v.bulkG.Get()
+	if err == nil {
+		err = res.Invalid("charging", "plugged", "current")
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if v.states.BulkStatus(res) != api.StatusC || res.Current == 0 {
+		return 0, api.ErrNotAvailable
+	}
+
+	return res.Current, nil
+}
+
+var _ api.VehicleTirePressure = (*Tronity)(nil)
+
+// TirePressure implements the api.VehicleTirePressure interface
+func (v *Tronity) TirePressure() (float64, float64, float64, float64, error) {
+	res, err := v.bulkG.Get()
+	if err == nil {
+		err = res.Invalid("tpms")
+	}
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+
+	if res.Tpms == nil {
+		return 0, 0, 0, 0, api.ErrNotAvailable
+	}
+
+	return res.Tpms.FrontLeft, res.Tpms.FrontRight, res.Tpms.RearLeft, res.Tpms.RearRight, nil
+}
+
+var _ api.VehicleClimater = (*Tronity)(nil)
+
+// Climater implements the api.VehicleClimater interface
+func (v *Tronity) Climater() (bool, error) {
+	res, err := v.bulkG.Get()
+	if err == nil {
+		err = res.Invalid("climate")
+	}
+	return res.Climate, err
+}
+
+// position implements the api.VehiclePosition interface
+func (v *Tronity) position() (float64, float64, error) {
+	res, err := v.bulkG.Get()
+	if err == nil {
+		err = res.Invalid("latitude", "longitude")
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// vehicles without gps fix report null island
+	if res.Latitude == 0 && res.Longitude == 0 {
+		return 0, 0, api.ErrNotAvailable
+	}
+
+	return float64(res.Latitude), float64(res.Longitude), nil
+}
+
+// quotaError marks api rate limit errors. The status error includes the retry delay if known.
+func quotaError(err error) error {
+	if se, ok := err.(request.StatusError); ok && se.HasStatus(http.StatusTooManyRequests) {
+		return fmt.Errorf("quota exhausted: %w", err)
+	}
 	return err
 }
 
+// post executes a vehicle command. Commands rejected with HTTP 405 are not supported
+// by the vehicle and are not sent again.
+func (v *Tronity) post(command, uri string) error {
+	return v.postJSON(command, uri, nil)
+}
+
+// postJSON sends a command with optional json payload to the vehicle
+func (v *Tronity) postJSON(command, uri string, data any) error {
+	v.mu.Lock()
+	unsupported := v.unsupported[command]
+	v.mu.Unlock()
+
+	if unsupported {
+		return api.ErrNotAvailable
+	}
+
+	if util.DryRun {
+		v.log.INFO.Printf("dry run: %s command not sent (%s)", command, uri)
+		return nil
+	}
+
+	// expired sponsorship is read-only
+	if sponsor.IsGracePeriod() {
+		return api.ErrSponsorRequired
+	}
+
+	b, err := v.command(uri, data)
+
+	// HTTP 408 indicates sleeping vehicle
+	if err2, ok := err.(request.StatusError); ok && err2.HasStatus(http.StatusRequestTimeout) && command != wakeupCommand {
+		b, err = v.wakeAndRetry(uri, data)
+	}
+
+	// HTTP 405 indicates unsupported command
+	if err != nil {
+		if err2, ok := err.(request.StatusError); ok && err2.HasStatus(http.StatusMethodNotAllowed) {
+			v.log.WARN.Printf("%s control not supported by vehicle", command)
+
+			v.mu.Lock()
+			v.unsupported[command] = true
+			v.mu.Unlock()
+
+			return api.ErrNotAvailable
+		}
+
+		err = quotaError(err)
+
+		// add api error message
+		if msg := strings.TrimSpace(string(b)); msg != "" {
+			err = fmt.Errorf("%w: %s", err, msg)
+		}
+
+		return err
+	}
+
+	// force bulk refresh to reflect changed vehicle state
+	v.bulkG.Reset()
+	v.parked.resume()
+
+	return nil
+}
+
+// command sends a command request with optional json payload and returns the response body.
+// Commands accepted for asynchronous execution with HTTP 202 are awaited until their job has finished.
+func (v *Tronity) command(uri string, data any) ([]byte, error) {
+	req, err := request.New(http.MethodPost, uri, request.MarshalJSON(data), request.JSONEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := v.DoResponse(req.WithContext(v.requestContext()))
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := request.ReadBody(resp)
+	if err != nil || resp.StatusCode != http.StatusAccepted {
+		return b, err
+	}
+
+	// accepted without job cannot be tracked
+	var job tronity.Job
+	if err := json.Unmarshal(b, &job); err != nil || job.ID == "" {
+		return b, nil
+	}
+
+	return nil, v.awaitJob(job.ID)
+}
+
+// awaitJob polls the job status until the asynchronous command has finished or the job timeout expires
+func (v *Tronity) awaitJob(id string) error {
+	ctx := v.requestContext()
+	uri := fmt.Sprintf("%s/v1/vehicles/%s/jobs/%s", v.uri, v.vid, id)
+	timeout := time.After(v.jobTimeout)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timeout:
+			return fmt.Errorf("job %s not finished within %v", id, v.jobTimeout)
+		case <-time.After(v.jobEvery):
+		}
+
+		var res tronity.Job
+		if err := v.GetJSONContext(ctx, uri, &res); err != nil {
+			return fmt.Errorf("job %s: %w", id, err)
+		}
+
+		switch res.Status {
+		case tronity.JobCompleted:
+			return nil
+		case tronity.JobFailed:
+			return fmt.Errorf("job %s failed: %s", id, res.Error)
+		}
+	}
+}
+
+// wakeAndRetry wakes the vehicle and retries the command until it succeeds or the wakeup timeout expires
+func (v *Tronity) wakeAndRetry(uri string, data any) ([]byte, error) {
+	v.log.DEBUG.Println("vehicle asleep, waking up")
+
+	if err := v.WakeUp(); err != nil {
+		return nil, fmt.Errorf("wakeup: %w", err)
+	}
+
+	ctx := v.requestContext()
+	deadline := time.Now().Add(v.wakeup)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wakeupRetryInterval):
+		}
+
+		b, err := v.command(uri, data)
+		if err2, ok := err.(request.StatusError); !ok || !err2.HasStatus(http.StatusRequestTimeout) || time.Now().After(deadline) {
+			return b, err
+		}
+	}
+}
+
+var _ api.Resurrector = (*Tronity)(nil)
+
+// WakeUp implements the api.Resurrector interface
+func (v *Tronity) WakeUp() error {
+	if !slices.Contains(v.scopes, tronity.WriteWakeUp) {
+		return api.ErrNotAvailable
+	}
+
+	uri := fmt.Sprintf("%s/v1/vehicles/%s/wake_up", v.uri, v.vid)
+	return v.post(wakeupCommand, uri)
+}
+
 // startCharge implements the api.VehicleChargeController interface
 func (v *Tronity) startCharge() error {
-	uri := fmt.Sprintf("%s/v1/vehicles/%s/charge_start", tronity.URI, v.vid)
-	return v.post(uri)
+	uri := fmt.Sprintf("%s/v1/vehicles/%s/charge_start", v.uri, v.vid)
+	if err := v.post(chargeCommand, uri); err != nil {
+		return err
+	}
+
+	return v.confirmStatus("charge start", func(status api.ChargeStatus) bool {
+		return status == api.StatusC
+	})
 }
 
 // stopCharge implements the api.VehicleChargeController interface
 func (v *Tronity) stopCharge() error {
-	uri := fmt.Sprintf("%s/v1/vehicles/%s/charge_stop", tronity.URI, v.vid)
-	return v.post(uri)
+	uri := fmt.Sprintf("%s/v1/vehicles/%s/charge_stop", v.uri, v.vid)
+	if err := v.post(chargeCommand, uri); err != nil {
+		return err
+	}
+
+	return v.confirmStatus("charge stop", func(status api.ChargeStatus) bool {
+		return status != api.StatusC
+	})
+}
+
+// confirmStatus polls the vehicle status until it is confirmed or the confirmation window expires.
+// Each poll bypasses the cache and counts towards the api quota.
+func (v *Tronity) confirmStatus(command string, confirmed func(api.ChargeStatus) bool) error {
+	if v.confirm <= 0 || util.DryRun {
+		return nil
+	}
+
+	ctx := v.requestContext()
+	interval := v.confirm / time.Duration(v.attempts)
+
+	var status api.ChargeStatus
+	for i := 0; i < v.attempts; i++ {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+
+		v.bulkG.Reset()
+
+		var err error
+		if status, err = v.status(); err == nil && confirmed(status) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s not confirmed within %v: status %s", command, v.confirm, status)
+}
+
+// locked implements the api.VehicleLocked interface
+func (v *Tronity) locked() (bool, error) {
+	res, err := v.bulkG.Get()
+	if err == nil {
+		err = res.Invalid("locked")
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if res.Locked == nil {
+		return false, api.ErrNotAvailable
+	}
+
+	return *res.Locked, nil
+}
+
+// lock implements the api.VehicleLock interface
+func (v *Tronity) lock() error {
+	uri := fmt.Sprintf("%s/v1/vehicles/%s/lock", v.uri, v.vid)
+	return v.post("lock", uri)
+}
+
+// unlock implements the api.VehicleLock interface
+func (v *Tronity) unlock() error {
+	uri := fmt.Sprintf("%s/v1/vehicles/%s/unlock", v.uri, v.vid)
+	return v.post("lock", uri)
+}
+
+// maxCurrent implements the api.VehicleCurrentController interface
+func (v *Tronity) maxCurrent(current float64) error {
+	data := struct {
+		Current float64 `json:"current"`
+	}{
+		Current: current,
+	}
+
+	uri := fmt.Sprintf("%s/v1/vehicles/%s/charge_current", v.uri, v.vid)
+	return v.postJSON(currentCommand, uri, data)
+}
+
+var _ api.VehicleChargeScheduler = (*Tronity)(nil)
+
+// ChargeSchedule implements the api.VehicleChargeScheduler interface
+func (v *Tronity) ChargeSchedule() (api.ChargeSchedule, error) {
+	res, err := v.schedule()
+	if err != nil || !res.Enabled {
+		return api.ChargeSchedule{}, err
+	}
+
+	return api.ChargeSchedule{Departure: res.Departure, Soc: res.TargetSoc}, nil
+}
+
+// SetChargeSchedule implements the api.VehicleChargeScheduler interface.
+// The schedule is owned by evcc only if the vehicle has no active schedule or if it
+// has been pushed by evcc. Schedules set in the vehicle or app are never overwritten.
+func (v *Tronity) SetChargeSchedule(schedule api.ChargeSchedule) error {
+	if !slices.Contains(v.scopes, tronity.WriteChargeStartStop) {
+		return api.ErrNotAvailable
+	}
+
+	current, err := v.schedule()
+	if err != nil {
+		return err
+	}
+
+	v.mu.Lock()
+	owned := v.scheduled != nil && current.Equal(*v.scheduled)
+	v.mu.Unlock()
+
+	if current.Enabled && !owned {
+		return errors.New("schedule not owned by evcc")
+	}
+
+	data := tronity.Schedule{
+		Enabled:   !schedule.Departure.IsZero(),
+		Departure: schedule.Departure,
+		TargetSoc: schedule.Soc,
+	}
+
+	// nothing to clear or update
+	if !data.Enabled && !current.Enabled || data.Enabled && current.Equal(data) {
+		return nil
+	}
+
+	uri := fmt.Sprintf("%s/v1/vehicles/%s/charge_schedule", v.uri, v.vid)
+	if err := v.postJSON(scheduleCommand, uri, data); err != nil {
+		return err
+	}
+
+	v.mu.Lock()
+	v.scheduled = nil
+	if data.Enabled {
+		v.scheduled = &data
+	}
+	v.mu.Unlock()
+
+	return nil
+}
+
+// schedule reads the vehicle's charge schedule. Schedules rejected with HTTP 405 are not supported.
+func (v *Tronity) schedule() (tronity.Schedule, error) {
+	var res tronity.Schedule
+
+	v.mu.Lock()
+	unsupported := v.unsupported[scheduleCommand]
+	v.mu.Unlock()
+
+	if unsupported {
+		return res, api.ErrNotAvailable
+	}
+
+	uri := fmt.Sprintf("%s/v1/vehicles/%s/charge_schedule", v.uri, v.vid)
+	err := v.GetJSONContext(v.requestContext(), uri, &res)
+
+	if se, ok := err.(request.StatusError); ok && se.HasStatus(http.StatusMethodNotAllowed) {
+		v.log.WARN.Printf("%s control not supported by vehicle", scheduleCommand)
+
+		v.mu.Lock()
+		v.unsupported[scheduleCommand] = true
+		v.mu.Unlock()
+
+		return res, api.ErrNotAvailable
+	}
+
+	return res, quotaError(err)
+}
+
+// startClimater implements the api.VehicleClimateController interface
+func (v *Tronity) startClimater() error {
+	uri := fmt.Sprintf("%s/v1/vehicles/%s/climate_start", v.uri, v.vid)
+	return v.post("climate", uri)
+}
+
+// stopClimater implements the api.VehicleClimateController interface
+func (v *Tronity) stopClimater() error {
+	uri := fmt.Sprintf("%s/v1/vehicles/%s/climate_stop", v.uri, v.vid)
+	return v.post("climate", uri)
 }
@@ -1,19 +1,76 @@
 package tronity
 
 import (
+	"fmt"
+	"strings"
+
 	"golang.org/x/oauth2"
 )
 
 const URI = "https://api-eu.tronity.io"
 
-func OAuth2Config(id, secret string) (*oauth2.Config, error) {
+// DefaultEnvironment is used if no environment is configured
+const DefaultEnvironment = "prod"
+
+// Environment is a Tronity deployment with its api and authentication hosts
+type Environment struct {
+	Name    string
+	URI     string // api base uri
+	AuthURI string // oauth endpoints base uri
+}
+
+// Environments are the known Tronity deployments
+var Environments = map[string]Environment{
+	"prod":    {URI: URI, AuthURI: URI},
+	"staging": {URI: "https://api-staging.tronity.io", AuthURI: "https://api-staging.tronity.io"},
+}
+
+// LookupEnvironment returns the named environment, defaulting to prod
+func LookupEnvironment(name string) (Environment, error) {
+	if name == "" {
+		name = DefaultEnvironment
+	}
+
+	name = strings.ToLower(name)
+
+	env, ok := Environments[name]
+	if !ok {
+		return Environment{}, fmt.Errorf("invalid environment: %s", name)
+	}
+	env.Name = name
+
+	return env, nil
+}
+
+// ResolveEnvironment combines the named environment with an optional api uri. The uri must match
+// the environment if both are given. A custom uri without environment is used for authentication, too.
+func ResolveEnvironment(name, uri string) (Environment, error) {
+	env, err := LookupEnvironment(name)
+	if err != nil {
+		return env, err
+	}
+
+	uri = strings.TrimSuffix(uri, "/")
+
+	switch {
+	case uri == "" || strings.EqualFold(uri, env.URI):
+		return env, nil
+	case name != "":
+		return env, fmt.Errorf("uri %s does not match environment %s", uri, env.Name)
+	default:
+		return Environment{Name: "custom", URI: uri, AuthURI: uri}, nil
+	}
+}
+
+// OAuth2Config returns the OAuth2 config for the api at given uri
+func OAuth2Config(id, secret, uri string) (*oauth2.Config, error) {
 	return &oauth2.Config{
 		ClientID:     id,
 		ClientSecret: secret,
 		Endpoint: oauth2.Endpoint{
-			AuthURL:  "https://api-eu.tronity.io/oauth/authorize",
-			TokenURL: "https://api-eu.tronity.io/oauth/authentication",
+			AuthURL:  uri + "/oauth/authorize",
+			TokenURL: uri + "/oauth/authentication",
 		},
-		Scopes: []string{"read_vin", "read_vehicle_info", "read_odometer", "read_charge", "read_charge", "read_battery", "read_location", "write_charge_start_stop", "write_wake_up"},
+		Scopes: []string{"read_vin", "read_vehicle_info", "read_odometer", "read_charge", "read_charge", "read_battery", "read_location", "write_charge_start_stop", "write_climate", "write_lock_unlock", "write_wake_up"},
 	}, nil
 }
@@ -0,0 +1,43 @@
+package tronity
+
+import (
+	"testing"
+)
+
+func TestResolveEnvironment(t *testing.T) {
+	staging := Environments["staging"].URI
+
+	tc := []struct {
+		env, uri string
+		name     string
+		res      string
+		err      bool
+	}{
+		{"", "", "prod", URI, false},
+		{"prod", URI + "/", "prod", URI, false},
+		{"Staging", "", "staging", staging, false},
+		{"", "https://tronity.example.com", "custom", "https://tronity.example.com", false},
+		{"staging", URI, "", "", true}, // mismatch
+		{"foo", "", "", "", true},
+	}
+
+	for _, tc := range tc {
+		t.Logf("%+v", tc)
+
+		env, err := ResolveEnvironment(tc.env, tc.uri)
+		if tc.err {
+			if err == nil {
+				t.Error("expected error")
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if env.Name != tc.name || env.URI != tc.res || env.AuthURI == "" {
+			t.Errorf("unexpected environment: %+v", env)
+		}
+	}
+}
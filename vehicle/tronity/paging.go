@@ -0,0 +1,41 @@
+package tronity
+
+import (
+	"errors"
+	"net/url"
+)
+
+// MaxPages limits the number of vehicle pages retrieved
+const MaxPages = 100
+
+// AllVehicles retrieves the vehicles from all pages starting at uri
+func AllVehicles(uri string, get func(uri string, res *Vehicles) error) ([]Vehicle, error) {
+	var vehicles []Vehicle
+
+	next := uri
+	for page := 0; page < MaxPages; page++ {
+		var res Vehicles
+		if err := get(next, &res); err != nil {
+			return nil, err
+		}
+
+		vehicles = append(vehicles, res.Data...)
+
+		if res.Next == "" {
+			return vehicles, nil
+		}
+
+		u, err := url.Parse(uri)
+		if err != nil {
+			return nil, err
+		}
+
+		q := u.Query()
+		q.Set("cursor", res.Next)
+		u.RawQuery = q.Encode()
+
+		next = u.String()
+	}
+
+	return nil, errors.New("too many vehicle pages")
+}
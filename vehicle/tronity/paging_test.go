@@ -0,0 +1,62 @@
+package tronity
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+)
+
+func TestAllVehicles(t *testing.T) {
+	pages := map[string]Vehicles{
+		"":  {Data: []Vehicle{{VIN: "1"}, {VIN: "2"}}, Next: "a"},
+		"a": {Data: []Vehicle{{VIN: "3"}}, Next: "b"},
+		"b": {Data: []Vehicle{{VIN: "4"}}},
+	}
+
+	get := func(uri string, res *Vehicles) error {
+		u, err := url.Parse(uri)
+		if err != nil {
+			return err
+		}
+
+		page, ok := pages[u.Query().Get("cursor")]
+		if !ok {
+			return fmt.Errorf("invalid page: %s", uri)
+		}
+
+		*res = page
+		return nil
+	}
+
+	res, err := AllVehicles("https://api/v1/vehicles", get)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(res) != 4 {
+		t.Fatalf("expected 4 vehicles, got %d", len(res))
+	}
+
+	for i, v := range res {
+		if exp := fmt.Sprint(i + 1); v.VIN != exp {
+			t.Errorf("expected vin %s, got %s", exp, v.VIN)
+		}
+	}
+}
+
+func TestAllVehiclesLimit(t *testing.T) {
+	var calls int
+	get := func(uri string, res *Vehicles) error {
+		calls++
+		*res = Vehicles{Data: []Vehicle{{}}, Next: "loop"}
+		return nil
+	}
+
+	if _, err := AllVehicles("https://api/v1/vehicles", get); err == nil {
+		t.Error("expected error")
+	}
+
+	if calls != MaxPages {
+		t.Errorf("expected %d calls, got %d", MaxPages, calls)
+	}
+}
@@ -0,0 +1,67 @@
+package tronity
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/evcc-io/evcc/api"
+)
+
+// ChargeStates maps Tronity charging states to charge status
+var ChargeStates = map[string]api.ChargeStatus{
+	"Charging":     api.StatusC,
+	"Complete":     api.StatusB,
+	"Stopped":      api.StatusB,
+	"NoPower":      api.StatusB,
+	"Disconnected": api.StatusA,
+}
+
+// StatusMapper converts charging states to charge status
+type StatusMapper map[string]api.ChargeStatus
+
+// NewStatusMapper creates a status mapper using the default charging states
+// and the given overrides of charging state to status (A/B/C)
+func NewStatusMapper(overrides map[string]string) (StatusMapper, error) {
+	res := make(StatusMapper, len(ChargeStates)+len(overrides))
+
+	for k, v := range ChargeStates {
+		res[strings.ToLower(k)] = v
+	}
+
+	for k, v := range overrides {
+		status, err := api.ChargeStatusString(v)
+		if err != nil {
+			return nil, fmt.Errorf("charging state %s: %w", k, err)
+		}
+
+		res[strings.ToLower(k)] = status
+	}
+
+	return res, nil
+}
+
+// Status returns the charge status for the charging state. Unknown states are considered disconnected.
+func (m StatusMapper) Status(state string) api.ChargeStatus {
+	if status, ok := m[strings.ToLower(state)]; ok {
+		return status
+	}
+
+	return api.StatusA
+}
+
+// BulkStatus returns the charge status considering the connector state if available.
+// A plugged vehicle that is not charging is considered connected.
+func (m StatusMapper) BulkStatus(res Bulk) api.ChargeStatus {
+	status := m.Status(res.Charging)
+
+	switch {
+	case res.Plugged == nil:
+		return status
+	case !*res.Plugged:
+		return api.StatusA
+	case status == api.StatusC:
+		return api.StatusC
+	default:
+		return api.StatusB
+	}
+}
@@ -0,0 +1,72 @@
+package tronity
+
+import (
+	"testing"
+
+	"github.com/evcc-io/evcc/api"
+)
+
+func TestStatusMapper(t *testing.T) {
+	m, err := NewStatusMapper(map[string]string{
+		"Paused":  "B",
+		"nopower": "A",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tc := []struct {
+		state  string
+		status api.ChargeStatus
+	}{
+		{"Charging", api.StatusC},
+		{"Complete", api.StatusB},
+		{"Stopped", api.StatusB},
+		{"Disconnected", api.StatusA},
+		{"NoPower", api.StatusA}, // overridden
+		{"Paused", api.StatusB},  // added
+		{"charging", api.StatusC},
+		{"", api.StatusA},
+		{"Unknown", api.StatusA},
+	}
+
+	for _, tc := range tc {
+		if status := m.Status(tc.state); status != tc.status {
+			t.Errorf("%s: expected %s, got %s", tc.state, tc.status, status)
+		}
+	}
+}
+
+func TestBulkStatus(t *testing.T) {
+	m, err := NewStatusMapper(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plugged, unplugged := true, false
+
+	tc := []struct {
+		res    Bulk
+		status api.ChargeStatus
+	}{
+		{Bulk{Charging: "Disconnected", Plugged: &unplugged}, api.StatusA},
+		{Bulk{Charging: "Disconnected", Plugged: &plugged}, api.StatusB}, // plugged but idle
+		{Bulk{Charging: "Unknown", Plugged: &plugged}, api.StatusB},
+		{Bulk{Charging: "Charging", Plugged: &plugged}, api.StatusC},
+		{Bulk{Charging: "Charging", Plugged: &unplugged}, api.StatusA},
+		{Bulk{Charging: "Stopped"}, api.StatusB}, // connector state not available
+		{Bulk{Charging: "Disconnected"}, api.StatusA},
+	}
+
+	for _, tc := range tc {
+		if status := m.BulkStatus(tc.res); status != tc.status {
+			t.Errorf("%+v: expected %s, got %s", tc.res, tc.status, status)
+		}
+	}
+}
+
+func TestStatusMapperInvalid(t *testing.T) {
+	if _, err := NewStatusMapper(map[string]string{"Charging": "X"}); err == nil {
+		t.Error("expected error")
+	}
+}
@@ -1,5 +1,15 @@
 package tronity
 
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+)
+
 // https://app.platform.tronity.io/docs#operation
 
 const (
@@ -10,11 +20,14 @@ const (
 	ReadVehicleInfo      = "read_vehicle_info"       // Know make, model, and year
 	ReadVIN              = "read_vin"                // Read VIN
 	WriteChargeStartStop = "write_charge_start_stop" // Start or stop your vehicle's charging
+	WriteClimate         = "write_climate"           // Start or stop climatisation
+	WriteLockUnlock      = "write_lock_unlock"       // Lock or unlock the vehicle
 	WriteWakeUp          = "write_wake_up"           // Wake up car. Only valid for Tesla
 )
 
 type Vehicles struct {
 	Data []Vehicle
+	Next string // cursor of next page, empty on last page
 }
 
 type Vehicle struct {
@@ -23,17 +36,91 @@ type Vehicle struct {
 	DisplayName string
 	Manufacture string
 	Scopes      []string
+	Capacity    *float64 // usable battery capacity in kWh
+	Nominal     *float64 `json:"nominalCapacity"` // nominal battery capacity in kWh
 }
 
 type Bulk struct {
-	VIN      string
-	Odometer float64
-	Range    float64
-	Level    float64
-	Charging string // Charging
-	// Latitude  float64/string
-	// Longitude float64/string
-	Timestamp int64
+	VIN         string
+	Odometer    *Number // km, nil if not reported
+	Range       *Number // km, nil if not reported
+	RatedRange  *Number // rated (EPA/WLTP) range in km
+	Level       Number
+	Charging    string   // Charging
+	Plugged     *bool    // charge cable connected
+	Power       float64  // kW
+	Energy      *float64 // cumulative charged energy in kWh
+	Current     float64  // A
+	ChargeStart int64    // charging session start in ms
+	Voltage     float64  // V
+	ChargeLimit *float64
+	Climate     bool
+	InsideTemp  *float64 // cabin temperature in °C
+	OutsideTemp *float64 // ambient temperature in °C
+	Locked      *bool
+	Latitude    Coordinate
+	Longitude   Coordinate
+	Tpms        *Tpms
+	Timestamp   int64 // ms
+
+	invalid map[string]error // fields that could not be decoded
+}
+
+// UnmarshalJSON decodes the bulk response field by field. Fields that cannot be decoded are
+// left empty and recorded, so that a single corrupt value does not fail the entire response.
+func (b *Bulk) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	type bulk Bulk
+	var res bulk
+
+	for key, val := range fields {
+		// decode into copy to discard partially decoded values
+		tmp := res
+
+		field, err := json.Marshal(map[string]json.RawMessage{key: val})
+		if err == nil {
+			err = json.Unmarshal(field, &tmp)
+		}
+
+		if err == nil {
+			res = tmp
+			continue
+		}
+
+		if res.invalid == nil {
+			res.invalid = make(map[string]error)
+		}
+		res.invalid[strings.ToLower(key)] = err
+	}
+
+	*b = Bulk(res)
+
+	return nil
+}
+
+// Invalid returns api.ErrNotAvailable if any of the fields could not be decoded
+func (b Bulk) Invalid(fields ...string) error {
+	for _, field := range fields {
+		if err, ok := b.invalid[strings.ToLower(field)]; ok {
+			return fmt.Errorf("%s: %w (%v)", field, api.ErrNotAvailable, err)
+		}
+	}
+
+	return nil
+}
+
+// Updated returns the time the vehicle last reported
+func (b Bulk) Updated() time.Time {
+	return time.UnixMilli(b.Timestamp)
+}
+
+// Tpms contains the tire pressures in bar
+type Tpms struct {
+	FrontLeft, FrontRight, RearLeft, RearRight float64
 }
 
 type Odometer struct {
@@ -52,8 +139,97 @@ type EVChargingStatus struct {
 	Timestamp int64
 }
 
+// Schedule is the vehicle's native charge schedule
+type Schedule struct {
+	Enabled   bool
+	Departure time.Time // charging finished by
+	TargetSoc int
+}
+
+// MarshalJSON encodes the departure in UTC and omits it if the schedule is disabled
+func (s Schedule) MarshalJSON() ([]byte, error) {
+	res := struct {
+		Enabled   bool       `json:"enabled"`
+		Departure *time.Time `json:"departureTime,omitempty"`
+		TargetSoc int        `json:"targetSoc,omitempty"`
+	}{
+		Enabled: s.Enabled,
+	}
+
+	if s.Enabled {
+		departure := s.Departure.UTC()
+		res.Departure = &departure
+		res.TargetSoc = s.TargetSoc
+	}
+
+	return json.Marshal(res)
+}
+
+// UnmarshalJSON decodes the schedule
+func (s *Schedule) UnmarshalJSON(data []byte) error {
+	var res struct {
+		Enabled   bool
+		Departure *time.Time `json:"departureTime"`
+		TargetSoc Number
+	}
+
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	*s = Schedule{Enabled: res.Enabled, TargetSoc: int(res.TargetSoc)}
+	if res.Departure != nil {
+		s.Departure = *res.Departure
+	}
+
+	return nil
+}
+
+// Equal returns true if both schedules charge to the same target at the same time
+func (s Schedule) Equal(o Schedule) bool {
+	return s.Enabled == o.Enabled && s.Departure.Equal(o.Departure) && s.TargetSoc == o.TargetSoc
+}
+
 type Location struct {
-	// Latitude  float64/string
-	// Longitude float64/string
+	Latitude  Coordinate
+	Longitude Coordinate
 	Timestamp int64
 }
+
+// job states of asynchronous commands
+const (
+	JobPending   = "pending"
+	JobCompleted = "completed"
+	JobFailed    = "failed"
+)
+
+// Job is an asynchronous command accepted with HTTP 202
+type Job struct {
+	ID     string
+	Status string
+	Error  string // failure reason
+}
+
+// Number implements JSON unmarshal for numbers encoded as number or string depending on vehicle firmware
+type Number float64
+
+// UnmarshalJSON decodes numeric or quoted numbers
+func (n *Number) UnmarshalJSON(data []byte) error {
+	s := strings.TrimSpace(strings.Trim(string(data), `"`))
+	if s == "" || s == "null" {
+		*n = 0
+		return nil
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return fmt.Errorf("invalid number: %s", data)
+	}
+
+	*n = Number(f)
+
+	return nil
+}
+
+// Coordinate implements JSON unmarshal for coordinates encoded as number or string
+type Coordinate = Number
@@ -0,0 +1,140 @@
+package tronity
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+)
+
+func TestBulkNumbers(t *testing.T) {
+	tc := []string{
+		`{"level":83,"range":210.5,"odometer":12345}`,
+		`{"level":"83","range":"210.5","odometer":"12345"}`,
+		`{"level":" 83 ","range":210.5,"odometer":"12345"}`,
+	}
+
+	for _, tc := range tc {
+		t.Log(tc)
+
+		var res Bulk
+		if err := json.Unmarshal([]byte(tc), &res); err != nil {
+			t.Fatal(err)
+		}
+
+		if res.Level != 83 || float64(res.Level) != 83.0 {
+			t.Errorf("unexpected level: %v", res.Level)
+		}
+
+		if res.Range == nil || int64(*res.Range) != 210 || float64(*res.Range) != 210.5 {
+			t.Errorf("unexpected range: %v", res.Range)
+		}
+
+		if res.Odometer == nil || float64(*res.Odometer) != 12345 {
+			t.Errorf("unexpected odometer: %v", res.Odometer)
+		}
+	}
+}
+
+func TestBulkNumbersInvalid(t *testing.T) {
+	var res Bulk
+
+	// empty strings and null are treated as missing values
+	if err := json.Unmarshal([]byte(`{"level":"","range":null}`), &res); err != nil {
+		t.Fatal(err)
+	}
+
+	if res.Level != 0 || res.Range != nil {
+		t.Errorf("unexpected result: %+v", res)
+	}
+
+	// invalid numbers are recorded instead of failing the response
+	if err := json.Unmarshal([]byte(`{"level":"foo"}`), &res); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := res.Invalid("level"); !errors.Is(err, api.ErrNotAvailable) {
+		t.Errorf("expected not available, got %v", err)
+	}
+}
+
+func TestBulkPartial(t *testing.T) {
+	var res Bulk
+
+	// corrupt range does not affect other fields
+	if err := json.Unmarshal([]byte(`{"level":83,"range":{"km":210},"charging":"Charging","odometer":12345}`), &res); err != nil {
+		t.Fatal(err)
+	}
+
+	if res.Level != 83 || res.Charging != "Charging" || res.Odometer == nil || *res.Odometer != 12345 || res.Range != nil {
+		t.Errorf("unexpected result: %+v", res)
+	}
+
+	if err := res.Invalid("level", "charging", "odometer"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if err := res.Invalid("Range"); !errors.Is(err, api.ErrNotAvailable) {
+		t.Errorf("expected not available, got %v", err)
+	}
+
+	// non-object payload still fails
+	if err := json.Unmarshal([]byte(`[]`), &res); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestEventJSON(t *testing.T) {
+	var res Event
+	if err := json.Unmarshal([]byte(`{"vehicleId":"1","level":83,"range":"foo"}`), &res); err != nil {
+		t.Fatal(err)
+	}
+
+	if res.VehicleID != "1" || res.Level != 83 || res.Invalid("range") == nil {
+		t.Errorf("unexpected result: %+v", res)
+	}
+}
+
+func TestScheduleJSON(t *testing.T) {
+	departure := time.Date(2024, 1, 2, 7, 30, 0, 0, time.FixedZone("CET", 3600))
+
+	tc := []struct {
+		schedule Schedule
+		json     string
+	}{
+		{Schedule{Enabled: true, Departure: departure, TargetSoc: 80}, `{"enabled":true,"departureTime":"2024-01-02T06:30:00Z","targetSoc":80}`},
+		{Schedule{Enabled: true, Departure: departure}, `{"enabled":true,"departureTime":"2024-01-02T06:30:00Z"}`},
+		{Schedule{Departure: departure, TargetSoc: 80}, `{"enabled":false}`},
+	}
+
+	for _, tc := range tc {
+		b, err := json.Marshal(tc.schedule)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(b) != tc.json {
+			t.Errorf("expected %s, got %s", tc.json, b)
+		}
+
+		var res Schedule
+		if err := json.Unmarshal(b, &res); err != nil {
+			t.Fatal(err)
+		}
+
+		if tc.schedule.Enabled && !res.Equal(tc.schedule) {
+			t.Errorf("roundtrip: expected %+v, got %+v", tc.schedule, res)
+		}
+	}
+
+	var res Schedule
+	if err := json.Unmarshal([]byte(`{"enabled":true,"departureTime":"2024-01-02T06:30:00Z","targetSoc":"80"}`), &res); err != nil {
+		t.Fatal(err)
+	}
+
+	if !res.Equal(Schedule{Enabled: true, Departure: departure, TargetSoc: 80}) {
+		t.Errorf("unexpected schedule: %+v", res)
+	}
+}
@@ -0,0 +1,45 @@
+package tronity
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+)
+
+// SignatureHeader is the webhook request header containing the payload signature
+const SignatureHeader = "X-Tronity-Signature"
+
+// Event is the webhook event payload
+type Event struct {
+	VehicleID string `json:"vehicleId"`
+	Bulk
+}
+
+// UnmarshalJSON decodes the vehicle id since the embedded bulk decoder would otherwise skip it
+func (e *Event) UnmarshalJSON(data []byte) error {
+	var res struct {
+		VehicleID string `json:"vehicleId"`
+	}
+
+	if err := json.Unmarshal(data, &res); err != nil {
+		return err
+	}
+
+	e.VehicleID = res.VehicleID
+
+	return e.Bulk.UnmarshalJSON(data)
+}
+
+// ValidSignature validates the hex-encoded HMAC-SHA256 payload signature
+func ValidSignature(secret string, payload []byte, signature string) bool {
+	sig, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return hmac.Equal(sig, mac.Sum(nil))
+}
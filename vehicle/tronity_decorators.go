@@ -6,12 +6,12 @@ import (
 	"github.com/evcc-io/evcc/api"
 )
 
-func decorateTronity(base *Tronity, chargeState func() (api.ChargeStatus, error), vehicleOdometer func() (float64, error), vehicleStartCharge func() error, vehicleStopCharge func() error) api.Vehicle {
+func decorateTronity(base *Tronity, chargeState func() (api.ChargeStatus, error), vehicleOdometer func() (float64, error), vehiclePosition func() (float64, float64, error), vehicleStartCharge func() error, vehicleStopCharge func() error, vehicleLock func() error, vehicleUnlock func() error, vehicleLocked func() (bool, error), vehicleMaxCurrent func(float64) error, vehicleStartClimater func() error, vehicleStopClimater func() error) api.Vehicle {
 	switch {
-	case chargeState == nil && vehicleOdometer == nil && vehicleStartCharge == nil && vehicleStopCharge == nil:
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition == nil:
 		return base
 
-	case chargeState != nil && vehicleOdometer == nil && vehicleStartCharge == nil && vehicleStopCharge == nil:
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition == nil:
 		return &struct {
 			*Tronity
 			api.ChargeState
@@ -22,7 +22,7 @@ func decorateTronity(base *Tronity, chargeState func() (api.ChargeStatus, error)
 			},
 		}
 
-	case chargeState == nil && vehicleOdometer != nil && vehicleStartCharge == nil && vehicleStopCharge == nil:
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition == nil:
 		return &struct {
 			*Tronity
 			api.VehicleOdometer
@@ -33,7 +33,7 @@ func decorateTronity(base *Tronity, chargeState func() (api.ChargeStatus, error)
 			},
 		}
 
-	case chargeState != nil && vehicleOdometer != nil && vehicleStartCharge == nil && vehicleStopCharge == nil:
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition == nil:
 		return &struct {
 			*Tronity
 			api.ChargeState
@@ -48,34 +48,5985 @@ func decorateTronity(base *Tronity, chargeState func() (api.ChargeStatus, error)
 			},
 		}
 
-	case chargeState == nil && vehicleOdometer == nil && vehicleStartCharge != nil && vehicleStopCharge != nil:
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition != nil:
 		return &struct {
 			*Tronity
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleLock
+		}{
+			Tronity: base,
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleLock
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleLock
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleLock
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleLock
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleLock
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleLock
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleLock
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleLock
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleLock
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleLock
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleLock
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleLock
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleLock
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleLock
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleLock
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleLocked
+		}{
+			Tronity: base,
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleLocked
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleLocked
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleLocked
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleLocked
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleLocked
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleLocked
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleLocked
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleLocked
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleLocked
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleLocked
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleLocked
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleLocked
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleLocked
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleLocked
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleLocked
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleLock
+			api.VehicleLocked
+		}{
+			Tronity: base,
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleLock
+			api.VehicleLocked
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleLock
+			api.VehicleLocked
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleLock
+			api.VehicleLocked
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleCurrentController
+		}{
+			Tronity: base,
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleCurrentController
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleCurrentController
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleCurrentController
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleCurrentController
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleCurrentController
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleCurrentController
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleCurrentController
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleCurrentController
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleCurrentController
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleCurrentController
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleCurrentController
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleCurrentController
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleCurrentController
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleCurrentController
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleCurrentController
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleCurrentController
+			api.VehicleLock
+		}{
+			Tronity: base,
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleCurrentController
+			api.VehicleLock
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleCurrentController
+			api.VehicleLock
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleCurrentController
+			api.VehicleLock
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleCurrentController
+			api.VehicleLocked
+		}{
+			Tronity: base,
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleCurrentController
+			api.VehicleLocked
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleCurrentController
+			api.VehicleLocked
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleCurrentController
+			api.VehicleLocked
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleCurrentController
+			api.VehicleLocked
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleCurrentController
+			api.VehicleLocked
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleCurrentController
+			api.VehicleLocked
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleCurrentController
+			api.VehicleLocked
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleCurrentController
+			api.VehicleLocked
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleCurrentController
+			api.VehicleLocked
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleCurrentController
+			api.VehicleLocked
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleCurrentController
+			api.VehicleLocked
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleCurrentController
+			api.VehicleLocked
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleCurrentController
+			api.VehicleLocked
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleCurrentController
+			api.VehicleLocked
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleCurrentController
+			api.VehicleLocked
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleLocked
+		}{
+			Tronity: base,
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleLocked
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleLocked
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleLocked
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater == nil && vehicleStopClimater == nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleClimateController
+		}{
+			Tronity: base,
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleClimateController
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleClimateController
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleClimateController
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleClimateController
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleClimateController
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleClimateController
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleClimateController
+			api.VehicleLock
+		}{
+			Tronity: base,
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleLock
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleClimateController
+			api.VehicleLock
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleLock
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleClimateController
+			api.VehicleLock
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleLock
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleClimateController
+			api.VehicleLock
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleLock
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleLock
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleLock
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleLock
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleLock
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleLock
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleLock
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleLock
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleLock
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleClimateController
+			api.VehicleLocked
+		}{
+			Tronity: base,
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleLocked
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleClimateController
+			api.VehicleLocked
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleLocked
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleClimateController
+			api.VehicleLocked
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleLocked
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleClimateController
+			api.VehicleLocked
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleLocked
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleLocked
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleLocked
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleLocked
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleLocked
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleLocked
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleLocked
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleLocked
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleLocked
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleClimateController
+			api.VehicleLock
+			api.VehicleLocked
+		}{
+			Tronity: base,
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleLock
+			api.VehicleLocked
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleClimateController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleClimateController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleClimateController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleLock
+			api.VehicleLocked
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleLock
+			api.VehicleLocked
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent == nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleClimateController
+			api.VehicleCurrentController
+		}{
+			Tronity: base,
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleCurrentController
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleCurrentController
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleCurrentController
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLock
+		}{
+			Tronity: base,
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLock
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLock
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLock
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked == nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLocked
+		}{
+			Tronity: base,
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLocked
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLocked
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLocked
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLocked
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLocked
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLocked
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLocked
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLocked
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLocked
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLocked
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLocked
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLocked
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLocked
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLocked
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock == nil && vehicleUnlock == nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
 			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLocked
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleLocked
 		}{
 			Tronity: base,
-			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
-				vehicleStartCharge: vehicleStartCharge,
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleLocked
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehicleOdometer
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge == nil && vehicleStopCharge == nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
 			},
 		}
 
-	case chargeState == nil && vehicleOdometer == nil && vehicleStartCharge != nil && vehicleStopCharge != nil:
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition == nil:
 		return &struct {
 			*Tronity
 			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleLocked
 		}{
 			Tronity: base,
 			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
 				vehicleStartCharge: vehicleStartCharge,
 				vehicleStopCharge:  vehicleStopCharge,
 			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
 		}
 
-	case chargeState != nil && vehicleOdometer == nil && vehicleStartCharge != nil && vehicleStopCharge != nil:
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition == nil:
 		return &struct {
 			*Tronity
 			api.ChargeState
 			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleLocked
 		}{
 			Tronity: base,
 			ChargeState: &decorateTronityChargeStateImpl{
@@ -85,42 +6036,244 @@ func decorateTronity(base *Tronity, chargeState func() (api.ChargeStatus, error)
 				vehicleStartCharge: vehicleStartCharge,
 				vehicleStopCharge:  vehicleStopCharge,
 			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
 		}
 
-	case chargeState == nil && vehicleOdometer != nil && vehicleStartCharge != nil && vehicleStopCharge != nil:
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition == nil:
 		return &struct {
 			*Tronity
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleLocked
 			api.VehicleOdometer
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition == nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
 			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehicleOdometer
 		}{
 			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
 			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
 				vehicleOdometer: vehicleOdometer,
 			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehiclePosition
+		}{
+			Tronity: base,
 			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
 				vehicleStartCharge: vehicleStartCharge,
 				vehicleStopCharge:  vehicleStopCharge,
 			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
 		}
 
-	case chargeState != nil && vehicleOdometer != nil && vehicleStartCharge != nil && vehicleStopCharge != nil:
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer == nil && vehiclePosition != nil:
 		return &struct {
 			*Tronity
 			api.ChargeState
-			api.VehicleOdometer
 			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehiclePosition
 		}{
 			Tronity: base,
 			ChargeState: &decorateTronityChargeStateImpl{
 				chargeState: chargeState,
 			},
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState == nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
+				vehicleStartCharge: vehicleStartCharge,
+				vehicleStopCharge:  vehicleStopCharge,
+			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
 			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
 				vehicleOdometer: vehicleOdometer,
 			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
+		}
+
+	case chargeState != nil && vehicleStartCharge != nil && vehicleStopCharge != nil && vehicleStartClimater != nil && vehicleStopClimater != nil && vehicleMaxCurrent != nil && vehicleLock != nil && vehicleUnlock != nil && vehicleLocked != nil && vehicleOdometer != nil && vehiclePosition != nil:
+		return &struct {
+			*Tronity
+			api.ChargeState
+			api.VehicleChargeController
+			api.VehicleClimateController
+			api.VehicleCurrentController
+			api.VehicleLock
+			api.VehicleLocked
+			api.VehicleOdometer
+			api.VehiclePosition
+		}{
+			Tronity: base,
+			ChargeState: &decorateTronityChargeStateImpl{
+				chargeState: chargeState,
+			},
 			VehicleChargeController: &decorateTronityVehicleChargeControllerImpl{
 				vehicleStartCharge: vehicleStartCharge,
 				vehicleStopCharge:  vehicleStopCharge,
 			},
+			VehicleClimateController: &decorateTronityVehicleClimateControllerImpl{
+				vehicleStartClimater: vehicleStartClimater,
+				vehicleStopClimater:  vehicleStopClimater,
+			},
+			VehicleCurrentController: &decorateTronityVehicleCurrentControllerImpl{
+				vehicleMaxCurrent: vehicleMaxCurrent,
+			},
+			VehicleLock: &decorateTronityVehicleLockImpl{
+				vehicleLock:   vehicleLock,
+				vehicleUnlock: vehicleUnlock,
+			},
+			VehicleLocked: &decorateTronityVehicleLockedImpl{
+				vehicleLocked: vehicleLocked,
+			},
+			VehicleOdometer: &decorateTronityVehicleOdometerImpl{
+				vehicleOdometer: vehicleOdometer,
+			},
+			VehiclePosition: &decorateTronityVehiclePositionImpl{
+				vehiclePosition: vehiclePosition,
+			},
 		}
 	}
 
@@ -135,14 +6288,6 @@ func (impl *decorateTronityChargeStateImpl) Status() (api.ChargeStatus, error) {
 	return impl.chargeState()
 }
 
-type decorateTronityVehicleOdometerImpl struct {
-	vehicleOdometer func() (float64, error)
-}
-
-func (impl *decorateTronityVehicleOdometerImpl) Odometer() (float64, error) {
-	return impl.vehicleOdometer()
-}
-
 type decorateTronityVehicleChargeControllerImpl struct {
 	vehicleStartCharge func() error
 	vehicleStopCharge  func() error
@@ -155,3 +6300,61 @@ func (impl *decorateTronityVehicleChargeControllerImpl) StartCharge() error {
 func (impl *decorateTronityVehicleChargeControllerImpl) StopCharge() error {
 	return impl.vehicleStopCharge()
 }
+
+type decorateTronityVehicleClimateControllerImpl struct {
+	vehicleStartClimater func() error
+	vehicleStopClimater  func() error
+}
+
+func (impl *decorateTronityVehicleClimateControllerImpl) StartClimater() error {
+	return impl.vehicleStartClimater()
+}
+
+func (impl *decorateTronityVehicleClimateControllerImpl) StopClimater() error {
+	return impl.vehicleStopClimater()
+}
+
+type decorateTronityVehicleCurrentControllerImpl struct {
+	vehicleMaxCurrent func(float64) error
+}
+
+func (impl *decorateTronityVehicleCurrentControllerImpl) MaxCurrent(current float64) error {
+	return impl.vehicleMaxCurrent(current)
+}
+
+type decorateTronityVehicleLockImpl struct {
+	vehicleLock   func() error
+	vehicleUnlock func() error
+}
+
+func (impl *decorateTronityVehicleLockImpl) Lock() error {
+	return impl.vehicleLock()
+}
+
+func (impl *decorateTronityVehicleLockImpl) Unlock() error {
+	return impl.vehicleUnlock()
+}
+
+type decorateTronityVehicleLockedImpl struct {
+	vehicleLocked func() (bool, error)
+}
+
+func (impl *decorateTronityVehicleLockedImpl) Locked() (bool, error) {
+	return impl.vehicleLocked()
+}
+
+type decorateTronityVehicleOdometerImpl struct {
+	vehicleOdometer func() (float64, error)
+}
+
+func (impl *decorateTronityVehicleOdometerImpl) Odometer() (float64, error) {
+	return impl.vehicleOdometer()
+}
+
+type decorateTronityVehiclePositionImpl struct {
+	vehiclePosition func() (float64, float64, error)
+}
+
+func (impl *decorateTronityVehiclePositionImpl) Position() (float64, float64, error) {
+	return impl.vehiclePosition()
+}
@@ -0,0 +1,1045 @@
+package vehicle
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/evcc-io/evcc/api"
+	"github.com/evcc-io/evcc/util"
+	"github.com/evcc-io/evcc/util/oauth"
+	"github.com/evcc-io/evcc/util/request"
+	"github.com/evcc-io/evcc/vehicle/tronity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func tronityServer(t *testing.T, vehicles []tronity.Vehicle, bulk tronity.Bulk) *httptest.Server {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/v1/vehicles", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(tronity.Vehicles{Data: vehicles})
+	})
+	mux.HandleFunc("/v1/vehicles/1/bulk", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(bulk)
+	})
+	mux.HandleFunc("/v1/vehicles/1/charge_start", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	})
+	mux.HandleFunc("/v1/vehicles/1/charge_stop", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	mux.HandleFunc("/v1/vehicles/1/lock", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/v1/vehicles/1/charge_current", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func testTronity(uri string) *Tronity {
+	log := util.NewLogger("test")
+	states, _ := tronity.NewStatusMapper(nil)
+
+	return &Tronity{
+		embed:  new(embed),
+		Helper: request.NewHelper(log),
+		log:    log,
+		uri:    uri,
+		socF:   new(socFilter),
+		energy: new(energyRater),
+		ctx:    context.Background(),
+		states: states,
+		bg:     newBackground(),
+
+		jobEvery:   time.Millisecond,
+		jobTimeout: time.Second,
+	}
+}
+
+func TestTronityMapping(t *testing.T) {
+	ptr := func(f tronity.Number) *tronity.Number { return &f }
+
+	tc := []struct {
+		bulk   tronity.Bulk
+		soc    float64
+		status api.ChargeStatus
+		rng    int64
+	}{
+		{tronity.Bulk{Level: 42, Range: ptr(210), Charging: "Charging"}, 42, api.StatusC, 210},
+		{tronity.Bulk{Level: 80, Range: ptr(400.7), Charging: "Disconnected"}, 80, api.StatusA, 400},
+		{tronity.Bulk{Level: 90, Range: ptr(450), Charging: "Complete"}, 90, api.StatusB, 450},
+	}
+
+	for _, tc := range tc {
+		t.Logf("%+v", tc)
+
+		srv := tronityServer(t, nil, tc.bulk)
+		v := testTronity(srv.URL).decorate(tronity.Vehicle{
+			ID:     "1",
+			Scopes: []string{tronity.ReadBattery, tronity.ReadCharge},
+		}, time.Minute)
+
+		soc, err := v.Soc()
+		require.NoError(t, err)
+		assert.Equal(t, tc.soc, soc)
+
+		vs, ok := v.(api.ChargeState)
+		require.True(t, ok)
+
+		status, err := vs.Status()
+		require.NoError(t, err)
+		assert.Equal(t, tc.status, status)
+
+		rng, err := v.(api.VehicleRange).Range()
+		require.NoError(t, err)
+		assert.Equal(t, tc.rng, rng)
+	}
+}
+
+func TestTronityEnsureVehicle(t *testing.T) {
+	v1 := tronity.Vehicle{ID: "1", VIN: "VIN1"}
+	v2 := tronity.Vehicle{ID: "2", VIN: "VIN2"}
+
+	tc := []struct {
+		vehicles []tronity.Vehicle
+		vin      string
+		res      tronity.Vehicle
+		err      bool
+	}{
+		{[]tronity.Vehicle{v1}, "", v1, false},         // single vehicle auto-select
+		{[]tronity.Vehicle{v1, v2}, "", v1, true},      // multiple vehicles require vin
+		{[]tronity.Vehicle{v1, v2}, "vin2", v2, false}, // vin match
+		{[]tronity.Vehicle{v1, v2}, "VIN3", v1, true},  // vin not found
+	}
+
+	for _, tc := range tc {
+		t.Logf("%+v", tc)
+
+		srv := tronityServer(t, tc.vehicles, tronity.Bulk{})
+		v := testTronity(srv.URL)
+
+		res, err := ensureVehicleEx(tc.vin, v.vehicles, func(v tronity.Vehicle) string {
+			return v.VIN
+		})
+
+		if tc.err {
+			assert.Error(t, err)
+			continue
+		}
+
+		require.NoError(t, err)
+		assert.Equal(t, tc.res, res)
+	}
+}
+
+func TestTronityEnsureVehiclePrefix(t *testing.T) {
+	v1 := tronity.Vehicle{ID: "1", VIN: "WVW111"}
+	v2 := tronity.Vehicle{ID: "2", VIN: "WVW222"}
+	v3 := tronity.Vehicle{ID: "3", VIN: "WVW2"}
+
+	tc := []struct {
+		vehicles []tronity.Vehicle
+		vin      string
+		res      tronity.Vehicle
+		err      bool
+	}{
+		{[]tronity.Vehicle{v1, v2}, "wvw111", v1, false}, // exact match
+		{[]tronity.Vehicle{v1, v2}, "wvw2", v2, false},   // unambiguous prefix
+		{[]tronity.Vehicle{v1, v2}, "WVW", v1, true},     // ambiguous prefix
+		{[]tronity.Vehicle{v2, v3}, "WVW2", v3, false},   // exact match takes precedence
+		{[]tronity.Vehicle{v1, v2}, "WVW3", v1, true},    // prefix not found
+	}
+
+	for _, tc := range tc {
+		t.Logf("%+v", tc)
+
+		srv := tronityServer(t, tc.vehicles, tronity.Bulk{})
+		v := testTronity(srv.URL)
+
+		res, err := ensureVehiclePrefixEx(tc.vin, v.vehicles, func(v tronity.Vehicle) string {
+			return v.VIN
+		})
+
+		if tc.err {
+			assert.Error(t, err)
+			continue
+		}
+
+		require.NoError(t, err)
+		assert.Equal(t, tc.res, res)
+	}
+
+	// exact matching by default
+	srv := tronityServer(t, []tronity.Vehicle{v1, v2}, tronity.Bulk{})
+	v := testTronity(srv.URL)
+
+	_, err := ensureVehicleEx("WVW2", v.vehicles, func(v tronity.Vehicle) string {
+		return v.VIN
+	})
+	assert.Error(t, err)
+}
+
+func TestTronityPost(t *testing.T) {
+	srv := tronityServer(t, nil, tronity.Bulk{})
+
+	v := testTronity(srv.URL)
+	v.decorate(tronity.Vehicle{ID: "1"}, time.Minute)
+
+	// 405 marks command as unsupported
+	err := v.startCharge()
+	assert.True(t, errors.Is(err, api.ErrNotAvailable), err)
+	assert.True(t, v.unsupported["charge"])
+
+	// 500 is propagated
+	v.unsupported = make(map[string]bool)
+
+	err = v.stopCharge()
+	assert.Error(t, err)
+	assert.False(t, errors.Is(err, api.ErrNotAvailable))
+
+	var se request.StatusError
+	assert.True(t, errors.As(err, &se))
+	assert.True(t, se.HasStatus(http.StatusInternalServerError))
+}
+
+func TestTronityCommandResetsCache(t *testing.T) {
+	var bulks atomic.Int32
+	charging := "Complete"
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/vehicles/1/bulk", func(w http.ResponseWriter, r *http.Request) {
+		bulks.Add(1)
+		_ = json.NewEncoder(w).Encode(tronity.Bulk{Level: 50, Charging: charging})
+	})
+	mux.HandleFunc("/v1/vehicles/1/charge_start", func(w http.ResponseWriter, r *http.Request) {
+		charging = "Charging"
+	})
+	mux.HandleFunc("/v1/vehicles/1/charge_stop", func(w http.ResponseWriter, r *http.Request) {
+		charging = "Complete"
+	})
+
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	vv := testTronity(srv.URL).decorate(tronity.Vehicle{
+		ID:     "1",
+		Scopes: []string{tronity.ReadBattery, tronity.ReadCharge, tronity.WriteChargeStartStop},
+	}, time.Hour)
+
+	vs := vv.(api.ChargeState)
+	vc := vv.(api.VehicleChargeController)
+
+	status := func() api.ChargeStatus {
+		res, err := vs.Status()
+		require.NoError(t, err)
+		return res
+	}
+
+	// cached read
+	assert.Equal(t, api.StatusB, status())
+	assert.Equal(t, api.StatusB, status())
+	assert.Equal(t, int32(1), bulks.Load())
+
+	// read after command bypasses the stale cache
+	require.NoError(t, vc.StartCharge())
+	assert.Equal(t, api.StatusC, status())
+	assert.Equal(t, int32(2), bulks.Load())
+
+	require.NoError(t, vc.StopCharge())
+	assert.Equal(t, api.StatusB, status())
+	assert.Equal(t, int32(3), bulks.Load())
+}
+
+func TestTronityLock(t *testing.T) {
+	locked := true
+	srv := tronityServer(t, nil, tronity.Bulk{Locked: &locked})
+
+	// lock requires write scope
+	vv := testTronity(srv.URL).decorate(tronity.Vehicle{ID: "1"}, time.Minute)
+
+	_, ok := vv.(api.VehicleLock)
+	assert.False(t, ok)
+	_, ok = vv.(api.VehicleLocked)
+	assert.False(t, ok)
+
+	vv = testTronity(srv.URL).decorate(tronity.Vehicle{ID: "1", Scopes: []string{tronity.WriteLockUnlock}}, time.Minute)
+
+	vl, ok := vv.(api.VehicleLock)
+	require.True(t, ok)
+	require.NoError(t, vl.Lock())
+
+	res, err := vv.(api.VehicleLocked).Locked()
+	require.NoError(t, err)
+	assert.True(t, res)
+}
+
+func TestTronityParked(t *testing.T) {
+	srv := tronityServer(t, nil, tronity.Bulk{Level: 50, Charging: "Disconnected"})
+
+	v := testTronity(srv.URL)
+	v.parkedEvery = time.Hour
+	v.decorate(tronity.Vehicle{ID: "1"}, time.Minute)
+
+	refresh := func() {
+		v.bulkG.Reset()
+		_, err := v.bulkG.Get()
+		require.NoError(t, err)
+	}
+
+	// unchanged vehicle state stretches polling
+	for i := 0; i <= parkedPolls; i++ {
+		assert.Equal(t, time.Minute, v.parked.next(time.Minute))
+		refresh()
+	}
+	assert.Equal(t, time.Hour, v.parked.next(time.Minute))
+
+	// command resumes normal polling
+	require.NoError(t, v.lock())
+	assert.Equal(t, time.Minute, v.parked.next(time.Minute))
+}
+
+func TestTronityAdaptiveCache(t *testing.T) {
+	srv := tronityServer(t, nil, tronity.Bulk{Level: 50, Charging: "Charging"})
+
+	v := testTronity(srv.URL)
+	v.charging = time.Minute
+	v.decorate(tronity.Vehicle{ID: "1"}, 10*time.Minute)
+
+	// interval shrinks with the cache duration while charging
+	assert.Equal(t, 30*time.Second, v.adapt(5*time.Minute, true))
+	assert.Equal(t, 5*time.Minute, v.adapt(5*time.Minute, false))
+
+	// refresher reads the charging status
+	v.poll(100 * time.Millisecond)
+	defer v.bg.Close(time.Second)
+
+	assert.Eventually(t, func() bool {
+		v.mu.Lock()
+		defer v.mu.Unlock()
+		return v.refresh == 10*time.Millisecond
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestTronityJob(t *testing.T) {
+	var polls atomic.Int32
+	var failed atomic.Bool
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/vehicles/1/lock", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		_ = json.NewEncoder(w).Encode(tronity.Job{ID: "42", Status: tronity.JobPending})
+	})
+	mux.HandleFunc("/v1/vehicles/1/jobs/42", func(w http.ResponseWriter, r *http.Request) {
+		res := tronity.Job{ID: "42", Status: tronity.JobPending}
+		if polls.Add(1) > 2 {
+			res.Status = tronity.JobCompleted
+			if failed.Load() {
+				res.Status = tronity.JobFailed
+				res.Error = "vehicle offline"
+			}
+		}
+		_ = json.NewEncoder(w).Encode(res)
+	})
+
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	v := testTronity(srv.URL)
+	v.decorate(tronity.Vehicle{ID: "1", Scopes: []string{tronity.WriteLockUnlock}}, time.Minute)
+
+	// completes asynchronously
+	require.NoError(t, v.lock())
+	assert.Equal(t, int32(3), polls.Load())
+
+	// failed
+	polls.Store(0)
+	failed.Store(true)
+	assert.ErrorContains(t, v.lock(), "job 42 failed: vehicle offline")
+
+	// never finishes
+	polls.Store(-1000)
+	v.jobTimeout = 20 * time.Millisecond
+	assert.ErrorContains(t, v.lock(), "not finished")
+}
+
+func TestTronityPartialBulk(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"level":42,"range":"n/a","charging":"Charging","odometer":12345}`))
+	}))
+	defer srv.Close()
+
+	v := testTronity(srv.URL).decorate(tronity.Vehicle{
+		ID:     "1",
+		Scopes: []string{tronity.ReadBattery, tronity.ReadCharge, tronity.ReadOdometer},
+	}, time.Minute)
+
+	soc, err := v.Soc()
+	require.NoError(t, err)
+	assert.Equal(t, 42.0, soc)
+
+	status, err := v.(api.ChargeState).Status()
+	require.NoError(t, err)
+	assert.Equal(t, api.StatusC, status)
+
+	odo, err := v.(api.VehicleOdometer).Odometer()
+	require.NoError(t, err)
+	assert.Equal(t, 12345.0, odo)
+
+	// only the corrupt field is unavailable
+	_, err = v.(api.VehicleRange).Range()
+	assert.ErrorIs(t, err, api.ErrNotAvailable)
+}
+
+func TestTronityChargeControlUnsupported(t *testing.T) {
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		calls++
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}))
+	defer srv.Close()
+
+	v := testTronity(srv.URL)
+	vv := v.decorate(tronity.Vehicle{ID: "1", Scopes: []string{tronity.WriteChargeStartStop}}, time.Minute)
+
+	cc, ok := vv.(api.VehicleChargeController)
+	require.True(t, ok)
+
+	// first 405 marks charge control as unsupported
+	assert.ErrorIs(t, cc.StartCharge(), api.ErrNotAvailable)
+	assert.True(t, v.unsupported[chargeCommand])
+
+	// not sent again
+	assert.ErrorIs(t, cc.StopCharge(), api.ErrNotAvailable)
+	assert.Equal(t, 1, calls)
+}
+
+func TestTronityFinishTime(t *testing.T) {
+	limit, capacity := 80.0, 50.0
+	bulk := tronity.Bulk{Level: 50, Charging: "Charging", Power: 11}
+
+	for _, tc := range []struct {
+		limit  *float64
+		target float64
+	}{
+		{nil, 100},
+		{&limit, 80},
+	} {
+		bulk.ChargeLimit = tc.limit
+		srv := tronityServer(t, nil, bulk)
+
+		v := testTronity(srv.URL)
+		v.decorate(tronity.Vehicle{ID: "1", Capacity: &capacity}, time.Minute)
+
+		finish, err := v.FinishTime()
+		require.NoError(t, err)
+
+		expected := chargeDuration(capacity, 50, tc.target, 11e3, v.ChargePower)
+		assert.InDelta(t, expected.Seconds(), time.Until(finish).Seconds(), 5, tc.target)
+	}
+}
+
+func TestTronityUpdated(t *testing.T) {
+	srv := tronityServer(t, nil, tronity.Bulk{Level: 50})
+
+	v := testTronity(srv.URL)
+	v.decorate(tronity.Vehicle{ID: "1"}, time.Minute)
+
+	// unknown data age
+	_, err := v.Updated()
+	assert.ErrorIs(t, err, api.ErrNotAvailable)
+
+	ts := time.Now().Add(-time.Hour).Truncate(time.Millisecond)
+	srv = tronityServer(t, nil, tronity.Bulk{Level: 50, Timestamp: ts.UnixMilli()})
+
+	v = testTronity(srv.URL)
+	v.decorate(tronity.Vehicle{ID: "1"}, time.Minute)
+
+	updated, err := v.Updated()
+	require.NoError(t, err)
+	assert.True(t, ts.Equal(updated))
+}
+
+func TestTronityUncached(t *testing.T) {
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		_ = json.NewEncoder(w).Encode(tronity.Bulk{Level: 50})
+	}))
+	defer srv.Close()
+
+	v := testTronity(srv.URL).decorate(tronity.Vehicle{
+		ID:     "1",
+		Scopes: []string{tronity.ReadBattery},
+	}, 0)
+
+	for i := 0; i < 2; i++ {
+		_, err := v.Soc()
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, 2, calls)
+}
+
+func TestTronityConfigErrors(t *testing.T) {
+	_, _, err := newTronity(map[string]interface{}{
+		"uri":     "http://tronity",
+		"timeout": "-1s",
+	})
+
+	var ce *util.ConfigError
+	require.ErrorAs(t, err, &ce)
+
+	// all problems reported at once
+	assert.ErrorContains(t, err, "invalid timeout")
+	assert.ErrorContains(t, err, "invalid uri")
+	assert.ErrorContains(t, err, "either credentials (app flow) or tokens (code flow) required")
+
+	// uri must match environment
+	_, _, err = newTronity(map[string]interface{}{
+		"uri": tronity.URI,
+		"env": "staging",
+	})
+	assert.ErrorContains(t, err, "does not match environment staging")
+
+	// all vehicles must be enabled explicitly
+	_, _, err = newTronity(map[string]interface{}{
+		"vehicles": "some",
+	})
+	assert.ErrorContains(t, err, "invalid vehicles: some")
+
+	_, _, err = newTronity(map[string]interface{}{
+		"vin":      "WVW",
+		"vehicles": "all",
+	})
+	assert.ErrorContains(t, err, "mutually exclusive")
+}
+
+func TestTronityRequireScopes(t *testing.T) {
+	var ce *util.ConfigError
+	err := requireScopes(tronity.Vehicle{VIN: "WVW", Scopes: []string{tronity.ReadCharge}})
+	require.ErrorAs(t, err, &ce)
+	assert.ErrorContains(t, err, tronity.ReadBattery)
+
+	assert.NoError(t, requireScopes(tronity.Vehicle{VIN: "WVW", Scopes: []string{tronity.ReadBattery}}))
+}
+
+func TestTronityTokenFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "tokens.json")
+	require.NoError(t, os.WriteFile(file, []byte(`{"access_token":"access","refresh_token":"refresh"}`), 0o600))
+
+	// tokens from file satisfy code flow
+	_, _, err := newTronity(map[string]interface{}{
+		"tokenFile": file,
+		"timeout":   "-1s",
+	})
+	assert.ErrorContains(t, err, "invalid timeout")
+	assert.NotContains(t, err.Error(), "tokens (code flow) required")
+
+	_, _, err = newTronity(map[string]interface{}{
+		"tokenFile": file,
+		"tokens":    map[string]string{"access": "a", "refresh": "r"},
+	})
+	assert.ErrorContains(t, err, "mutually exclusive")
+
+	_, _, err = newTronity(map[string]interface{}{
+		"tokenFile": filepath.Join(t.TempDir(), "missing.json"),
+	})
+	assert.ErrorContains(t, err, "token file")
+}
+
+func TestTronityCapacity(t *testing.T) {
+	capacity := 77.0
+
+	// detected if not configured
+	v := testTronity("")
+	v.decorate(tronity.Vehicle{ID: "1", Capacity: &capacity}, time.Minute)
+	assert.Equal(t, capacity, v.Capacity())
+
+	// configured capacity takes precedence
+	v = testTronity("")
+	v.Capacity_ = 60
+	v.decorate(tronity.Vehicle{ID: "1", Capacity: &capacity}, time.Minute)
+	assert.Equal(t, 60.0, v.Capacity())
+}
+
+func TestTronityBatteryCapacities(t *testing.T) {
+	usable, nominal := 77.0, 82.0
+
+	tc := []struct {
+		configured, configuredNominal float64
+		vehicle                       tronity.Vehicle
+		usable, nominal               float64
+	}{
+		// configured value without vehicle metadata
+		{60, 0, tronity.Vehicle{ID: "1"}, 60, 60},
+		{60, 65, tronity.Vehicle{ID: "1"}, 60, 65},
+		// vehicle metadata takes precedence
+		{60, 65, tronity.Vehicle{ID: "1", Capacity: &usable}, 77, 65},
+		{60, 65, tronity.Vehicle{ID: "1", Capacity: &usable, Nominal: &nominal}, 77, 82},
+		// nominal defaults to usable capacity
+		{0, 0, tronity.Vehicle{ID: "1", Capacity: &usable}, 77, 77},
+		{0, 0, tronity.Vehicle{ID: "1"}, 0, 0},
+	}
+
+	for _, tc := range tc {
+		t.Logf("%+v", tc)
+
+		v := testTronity("")
+		v.Capacity_ = tc.configured
+		v.Nominal_ = tc.configuredNominal
+
+		bc, ok := v.decorate(tc.vehicle, time.Minute).(api.BatteryCapacities)
+		require.True(t, ok)
+
+		assert.Equal(t, tc.usable, bc.UsableCapacity())
+		assert.Equal(t, tc.nominal, bc.NominalCapacity())
+	}
+}
+
+func TestTronityPoll(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		_ = json.NewEncoder(w).Encode(tronity.Bulk{Level: 50})
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	v := testTronity(srv.URL)
+	v.SetContext(ctx)
+	v.decorate(tronity.Vehicle{ID: "1"}, time.Hour)
+
+	// background refresh despite long cache duration
+	v.poll(10 * time.Millisecond)
+	assert.Eventually(t, func() bool { return calls.Load() >= 2 }, time.Second, 10*time.Millisecond)
+}
+
+func TestTronityPollShutdown(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		_ = json.NewEncoder(w).Encode(tronity.Bulk{Level: 50})
+	}))
+	defer srv.Close()
+
+	v := testTronity(srv.URL)
+	v.SetContext(context.Background())
+	v.decorate(tronity.Vehicle{ID: "1"}, time.Hour)
+
+	v.poll(10 * time.Millisecond)
+	assert.Eventually(t, func() bool { return calls.Load() >= 1 }, time.Second, 10*time.Millisecond)
+
+	// poll loop and pending requests stop on shutdown
+	assert.NoError(t, v.bg.Close(time.Second))
+	assert.Eventually(t, func() bool { return v.requestContext().Err() != nil }, time.Second, 10*time.Millisecond)
+
+	n := calls.Load()
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, n, calls.Load())
+}
+
+func TestTronityETag(t *testing.T) {
+	var written []int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `W/"1"` {
+			w.WriteHeader(http.StatusNotModified)
+			written = append(written, 0)
+			return
+		}
+
+		b, _ := json.Marshal(tronity.Bulk{Level: 50, Charging: "Charging"})
+		w.Header().Set("ETag", `W/"1"`)
+		n, _ := w.Write(b)
+		written = append(written, n)
+	}))
+	defer srv.Close()
+
+	v := testTronity(srv.URL).decorate(tronity.Vehicle{
+		ID:     "1",
+		Scopes: []string{tronity.ReadBattery},
+	}, 0)
+
+	for i := 0; i < 2; i++ {
+		soc, err := v.Soc()
+		require.NoError(t, err)
+		assert.Equal(t, 50.0, soc)
+	}
+
+	// unchanged data is not transferred again
+	require.Len(t, written, 2)
+	assert.Equal(t, 0, written[1])
+	t.Logf("bulk payload: %d bytes, saved %d bytes per unchanged request", written[0], written[0]-written[1])
+}
+
+func TestTronityMaxCurrent(t *testing.T) {
+	var calls int
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/vehicles/1/charge_current", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	})
+
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	// current control requires charge scope
+	vv := testTronity(srv.URL).decorate(tronity.Vehicle{ID: "1"}, time.Minute)
+
+	_, ok := vv.(api.VehicleCurrentController)
+	assert.False(t, ok)
+
+	v := testTronity(srv.URL)
+	vv = v.decorate(tronity.Vehicle{ID: "1", Scopes: []string{tronity.WriteChargeStartStop}}, time.Minute)
+
+	vc, ok := vv.(api.VehicleCurrentController)
+	require.True(t, ok)
+
+	// 405 marks current control as unsupported
+	err := vc.MaxCurrent(10)
+	assert.True(t, errors.Is(err, api.ErrNotAvailable), err)
+	assert.True(t, v.unsupported[currentCommand])
+
+	// unsupported command is not sent again
+	err = vc.MaxCurrent(12)
+	assert.True(t, errors.Is(err, api.ErrNotAvailable), err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestTronityClimateControl(t *testing.T) {
+	var started, stopped bool
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/vehicles/1/climate_start", func(w http.ResponseWriter, r *http.Request) {
+		started = true
+	})
+	mux.HandleFunc("/v1/vehicles/1/climate_stop", func(w http.ResponseWriter, r *http.Request) {
+		stopped = true
+	})
+	mux.HandleFunc("/v1/vehicles/1/bulk", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(tronity.Bulk{})
+	})
+
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	// climate control requires climate scope
+	vv := testTronity(srv.URL).decorate(tronity.Vehicle{ID: "1"}, time.Minute)
+
+	_, ok := vv.(api.VehicleClimateController)
+	assert.False(t, ok)
+
+	vv = testTronity(srv.URL).decorate(tronity.Vehicle{ID: "1", Scopes: []string{tronity.WriteClimate}}, time.Minute)
+
+	vc, ok := vv.(api.VehicleClimateController)
+	require.True(t, ok)
+
+	require.NoError(t, vc.StartClimater())
+	assert.True(t, started)
+
+	require.NoError(t, vc.StopClimater())
+	assert.True(t, stopped)
+}
+
+func TestTronityTokenStoreKey(t *testing.T) {
+	assert.Equal(t, "tronity.client", tokenStoreKey("client", "refresh"))
+
+	// code flow is keyed by refresh token
+	key := tokenStoreKey("", "refresh")
+	assert.NotEqual(t, "tronity.", key)
+	assert.Equal(t, key, tokenStoreKey("", "refresh"))
+	assert.NotEqual(t, key, tokenStoreKey("", "other"))
+}
+
+func TestTronitySetName(t *testing.T) {
+	srv := tronityServer(t, nil, tronity.Bulk{})
+
+	vv := testTronity(srv.URL).decorate(tronity.Vehicle{ID: "1"}, time.Minute)
+
+	ns, ok := vv.(interface{ SetName(string) })
+	require.True(t, ok)
+	ns.SetName("car")
+
+	assert.Equal(t, "car", vv.(api.NameDescriber).Name())
+}
+
+func TestTronityDiagnose(t *testing.T) {
+	srv := tronityServer(t, nil, tronity.Bulk{})
+
+	v := testTronity(srv.URL)
+	v.decorate(tronity.Vehicle{ID: "1"}, time.Minute)
+
+	var buf bytes.Buffer
+	v.log.INFO.SetOutput(&buf)
+
+	// diagnosis is written to the vehicle's logger
+	v.Diagnose()
+	assert.Contains(t, buf.String(), "soc (smoothed)")
+}
+
+func TestTronityLogArea(t *testing.T) {
+	assert.Equal(t, "tronity", logArea("tronity", ""))
+	assert.Equal(t, "tronity-123456", logArea("tronity", "WVWZZZ1JZ3W123456"))
+	assert.Equal(t, "garage-abc", logArea("garage", "ABC"))
+}
+
+func TestTronityConcurrentRefresh(t *testing.T) {
+	var refreshes int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&refreshes, 1)
+		time.Sleep(50 * time.Millisecond)
+		_ = json.NewEncoder(w).Encode(oauth2.Token{AccessToken: "access", Expiry: time.Now().Add(time.Hour)})
+	}))
+	defer srv.Close()
+
+	v := testTronity(srv.URL)
+	v.oc = &oauth2.Config{Endpoint: oauth2.Endpoint{TokenURL: srv.URL}}
+	v.ts = oauth.NewSingleFlightTokenSource(oauth.RefreshTokenSource(&oauth2.Token{}, v))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := v.ts.Token()
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&refreshes))
+}
+
+func TestTronityRange(t *testing.T) {
+	rated, current := tronity.Number(450), tronity.Number(380)
+	srv := tronityServer(t, nil, tronity.Bulk{Range: &current, RatedRange: &rated})
+
+	v := testTronity(srv.URL)
+	v.decorate(tronity.Vehicle{ID: "1"}, 0)
+
+	rng, err := v.Range()
+	require.NoError(t, err)
+	assert.Equal(t, int64(380), rng)
+
+	r, c, err := v.RangeDetail()
+	require.NoError(t, err)
+	assert.Equal(t, []int64{450, 380}, []int64{r, c})
+
+	v.ratedRange = true
+	rng, err = v.Range()
+	require.NoError(t, err)
+	assert.Equal(t, int64(450), rng)
+}
+
+func TestTronityPartialBulkFields(t *testing.T) {
+	tc := []struct {
+		json     string
+		rng      int64
+		odometer float64
+		err      error
+	}{
+		{`{"level":50,"range":0,"odometer":0}`, 0, 0, nil},
+		{`{"level":50,"range":210,"odometer":12345.6}`, 210, 12345.6, nil},
+		{`{"level":50,"range":null,"odometer":null}`, 0, 0, api.ErrNotAvailable},
+		{`{"level":50}`, 0, 0, api.ErrNotAvailable},
+	}
+
+	for _, tc := range tc {
+		t.Logf("%+v", tc)
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(tc.json))
+		}))
+
+		v := testTronity(srv.URL)
+		v.decorate(tronity.Vehicle{ID: "1"}, 0)
+
+		rng, err := v.Range()
+		assert.Equal(t, tc.err, err)
+		assert.Equal(t, tc.rng, rng)
+
+		odo, err := v.odometer()
+		assert.Equal(t, tc.err, err)
+		assert.Equal(t, tc.odometer, odo)
+
+		srv.Close()
+	}
+}
+
+func TestTronityLastResponse(t *testing.T) {
+	srv := tronityServer(t, nil, tronity.Bulk{Level: 42})
+
+	v := testTronity(srv.URL)
+	v.Helper.WithCapture()
+	v.decorate(tronity.Vehicle{ID: "1"}, 0)
+
+	_, err := v.bulkG.Get()
+	require.NoError(t, err)
+	assert.Contains(t, string(v.LastResponse()), `"Level":42`)
+}
+
+func TestTronityHeaders(t *testing.T) {
+	var header http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		_ = json.NewEncoder(w).Encode(tronity.Vehicles{})
+	}))
+	defer srv.Close()
+
+	v := testTronity(srv.URL)
+	v.ts = oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "access"})
+	v.headers = map[string]string{"X-Region": "eu"}
+	v.Helper = v.newHelper(v.log, time.Second)
+
+	_, err := v.vehicles()
+	require.NoError(t, err)
+
+	// merged with authorization
+	assert.Equal(t, "eu", header.Get("X-Region"))
+	assert.Equal(t, "Bearer access", header.Get("Authorization"))
+
+	_, _, err = newTronity(map[string]interface{}{
+		"headers": map[string]string{"authorization": "foo"},
+	})
+	assert.ErrorContains(t, err, "invalid header: authorization")
+}
+
+func TestTronityUserAgent(t *testing.T) {
+	var header http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		_ = json.NewEncoder(w).Encode(tronity.Vehicles{})
+	}))
+	defer srv.Close()
+
+	v := testTronity(srv.URL)
+	v.ts = oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "access"})
+	v.userAgent = "evcc/test"
+	v.Helper = v.newHelper(v.log, time.Second)
+
+	_, err := v.vehicles()
+	require.NoError(t, err)
+
+	// set on the authenticated transport
+	assert.Equal(t, "evcc/test", header.Get("User-Agent"))
+	assert.Equal(t, "Bearer access", header.Get("Authorization"))
+}
+
+func TestTronityOfflineThreshold(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	v := testTronity(srv.URL)
+	v.threshold = 2
+	v.decorate(tronity.Vehicle{ID: "1"}, time.Minute)
+
+	// single failure is not reported
+	_, err := v.bulkG.Get()
+	require.Error(t, err)
+	_, err = v.Health()
+	assert.NoError(t, err)
+
+	v.bulkG.Reset()
+	_, err = v.bulkG.Get()
+	assert.ErrorIs(t, err, api.ErrNotAvailable)
+	_, err = v.Health()
+	assert.Error(t, err)
+}
+
+func TestTronityDisableLocation(t *testing.T) {
+	srv := tronityServer(t, nil, tronity.Bulk{Level: 50, Latitude: 52.5, Longitude: 13.4})
+
+	v := testTronity(srv.URL)
+	v.ts = oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "access"})
+	v.noLocation = true
+	v.Helper = v.newHelper(v.log, time.Second)
+
+	res := v.decorate(tronity.Vehicle{ID: "1", Scopes: []string{tronity.ReadBattery, tronity.ReadLocation}}, time.Minute)
+
+	_, ok := res.(api.VehiclePosition)
+	assert.False(t, ok, "position not disabled")
+
+	_, err := v.bulkG.Get()
+	require.NoError(t, err)
+
+	// position is neither retained nor captured
+	assert.Zero(t, v.last.Latitude)
+	assert.Zero(t, v.last.Longitude)
+	assert.NotContains(t, string(v.LastResponse()), "52.5")
+	assert.NotContains(t, string(v.LastResponse()), "13.4")
+}
+
+func TestTronityTemperature(t *testing.T) {
+	inside := 21.5
+	srv := tronityServer(t, nil, tronity.Bulk{InsideTemp: &inside})
+
+	v := testTronity(srv.URL)
+	v.decorate(tronity.Vehicle{ID: "1"}, time.Minute)
+
+	temp, err := v.InsideTemp()
+	require.NoError(t, err)
+	assert.Equal(t, 21.5, temp)
+
+	_, err = v.OutsideTemp()
+	assert.ErrorIs(t, err, api.ErrNotAvailable)
+}
+
+func TestTronityConfirm(t *testing.T) {
+	var polls atomic.Int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/vehicles/1/bulk", func(w http.ResponseWriter, r *http.Request) {
+		// vehicle starts charging on the second poll
+		charging := "Stopped"
+		if polls.Add(1) > 1 {
+			charging = "Charging"
+		}
+		_ = json.NewEncoder(w).Encode(tronity.Bulk{Charging: charging})
+	})
+	mux.HandleFunc("/v1/vehicles/1/charge_start", func(w http.ResponseWriter, r *http.Request) {})
+	mux.HandleFunc("/v1/vehicles/1/charge_stop", func(w http.ResponseWriter, r *http.Request) {})
+
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	v := testTronity(srv.URL)
+	v.decorate(tronity.Vehicle{ID: "1"}, time.Minute)
+	v.confirm = 30 * time.Millisecond
+	v.attempts = 3
+
+	require.NoError(t, v.startCharge())
+	assert.Equal(t, int32(2), polls.Load())
+
+	// vehicle keeps charging
+	err := v.stopCharge()
+	assert.ErrorContains(t, err, "charge stop not confirmed")
+	assert.Equal(t, int32(5), polls.Load())
+
+	// invalid confirmation window
+	_, _, err = newTronity(map[string]interface{}{
+		"confirm": map[string]interface{}{"timeout": "1m", "attempts": 0},
+	})
+	assert.ErrorContains(t, err, "invalid confirm")
+}
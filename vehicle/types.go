@@ -33,3 +33,8 @@ func (t *Tokens) Error() error {
 
 	return nil
 }
+
+// Home contains the vehicle's home location and radius in m
+type Home struct {
+	Latitude, Longitude, Radius float64
+}
@@ -3,6 +3,7 @@ package wrapper
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/evcc-io/evcc/api"
 	"github.com/evcc-io/evcc/util"
@@ -11,6 +12,7 @@ import (
 // Wrapper wraps an api.Vehicle to capture initialization errors
 type Wrapper struct {
 	err       error
+	name      string
 	title     string
 	icon      string
 	phases    int
@@ -38,6 +40,7 @@ func New(name string, other map[string]interface{}, err error) api.Vehicle {
 
 	v := &Wrapper{
 		err:       fmt.Errorf("vehicle not available: %w", err),
+		name:      name,
 		title:     fmt.Sprintf("%s (offline)", cc.Title),
 		icon:      cc.Icon,
 		phases:    cc.Phases,
@@ -55,6 +58,13 @@ func (v *Wrapper) Title() string {
 	return v.title
 }
 
+var _ api.NameDescriber = (*Wrapper)(nil)
+
+// Name implements the api.NameDescriber interface
+func (v *Wrapper) Name() string {
+	return v.name
+}
+
 // SetTitle implements the api.TitleSetter interface
 func (v *Wrapper) SetTitle(title string) {
 	v.title = fmt.Sprintf("%s (unavailable)", title)
@@ -98,3 +108,10 @@ var _ api.Battery = (*Wrapper)(nil)
 func (v *Wrapper) Soc() (float64, error) {
 	return 0, v.err
 }
+
+var _ api.HealthReporter = (*Wrapper)(nil)
+
+// Health implements the api.HealthReporter interface
+func (v *Wrapper) Health() (time.Time, error) {
+	return time.Time{}, v.err
+}